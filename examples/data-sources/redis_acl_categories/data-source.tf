@@ -0,0 +1,5 @@
+data "redis_acl_categories" "all" {}
+
+output "dangerous_commands" {
+  value = data.redis_acl_categories.all.commands["dangerous"]
+}
@@ -0,0 +1,7 @@
+data "redis_acl_user" "reporting" {
+  name = "reporting"
+}
+
+output "reporting_categories" {
+  value = data.redis_acl_user.reporting.categories
+}
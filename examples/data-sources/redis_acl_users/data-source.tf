@@ -0,0 +1,8 @@
+data "redis_acl_users" "services" {
+  name_prefix   = "svc-"
+  include_rules = true
+}
+
+output "service_users" {
+  value = data.redis_acl_users.services.names
+}
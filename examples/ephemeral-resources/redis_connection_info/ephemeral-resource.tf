@@ -0,0 +1,23 @@
+ephemeral "redis_password" "app" {}
+
+resource "redis_acl_user" "app" {
+  name                = "app"
+  password_wo         = ephemeral.redis_password.app.result
+  password_wo_version = "1"
+  categories          = ["read", "write"]
+  keys                = ["app:*"]
+}
+
+ephemeral "redis_connection_info" "app" {
+  username = redis_acl_user.app.name
+  password = ephemeral.redis_password.app.result
+}
+
+resource "vault_kv_secret_v2" "app" {
+  mount = "secret"
+  name  = "app/redis"
+  data_json_wo = jsonencode({
+    url = ephemeral.redis_connection_info.app.url
+  })
+  data_json_wo_version = 1
+}
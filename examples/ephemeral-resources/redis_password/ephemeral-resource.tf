@@ -0,0 +1,12 @@
+ephemeral "redis_password" "app" {
+  length           = 40
+  charset          = "base64url"
+  min_entropy_bits = 192
+}
+
+resource "redis_acl_user" "app" {
+  name                = "app"
+  password_wo         = ephemeral.redis_password.app.result
+  password_wo_version = "1"
+  categories          = ["read"]
+}
@@ -0,0 +1,5 @@
+ephemeral "redis_temporary_acl_user" "ci" {
+  name_prefix = "ci-"
+  categories  = ["read", "write"]
+  keys        = ["ci:*"]
+}
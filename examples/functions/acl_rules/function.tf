@@ -0,0 +1,11 @@
+locals {
+  app_user = {
+    categories = ["read", "write"]
+    keys       = ["app:*"]
+    channels   = ["app-events"]
+  }
+}
+
+output "aclfile_line" {
+  value = "user app ${provider::redis::acl_rules(local.app_user)}"
+}
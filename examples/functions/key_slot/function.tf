@@ -0,0 +1,3 @@
+output "profile_slot" {
+  value = provider::redis::key_slot("{user1000}.profile")
+}
@@ -0,0 +1,3 @@
+output "app_keys" {
+  value = provider::redis::parse_acl_rules("user app on ~app:* &app-events +@read +@write").keys
+}
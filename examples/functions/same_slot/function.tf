@@ -0,0 +1,9 @@
+variable "session_keys" {
+  type    = list(string)
+  default = ["{session:42}.data", "{session:42}.expiry"]
+
+  validation {
+    condition     = provider::redis::same_slot(var.session_keys)
+    error_message = "All session keys must share a hash tag so they land in the same cluster slot."
+  }
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// aclDryRun runs ACL DRYRUN on every node and reports whether username may
+// run the command on all of them, along with the reason given by the first
+// node denying it.
+func aclDryRun(ctx context.Context, client redis.UniversalClient, username string, command string, args []string) (bool, string, error) {
+	var mu sync.Mutex
+	allowed, reason := true, ""
+	cmdArgs := append([]any{"ACL", "DRYRUN", username, command}, toAny(args)...)
+	err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		reply, err := node.Do(ctx, cmdArgs...).Text()
+		if err != nil {
+			return err
+		}
+		if reply == "OK" {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if allowed {
+			allowed, reason = false, reply
+		}
+		return nil
+	})
+	if err != nil {
+		return false, "", err
+	}
+	return allowed, reason, nil
+}
+
+// assertionFailure describes why an assertion does not hold, or returns ""
+// when the dry run matched the expectation.
+func assertionFailure(a *RedisAclAssertModel, allowed bool, reason string) string {
+	command := a.Command.ValueString()
+	for _, arg := range toStringList(a.Args) {
+		command += " " + arg.ValueString()
+	}
+	switch {
+	case a.Allowed.ValueBool() && !allowed:
+		return fmt.Sprintf("expected %q to be allowed, but it was denied: %s", command, reason)
+	case !a.Allowed.ValueBool() && allowed:
+		return fmt.Sprintf("expected %q to be denied, but it was allowed", command)
+	}
+	return ""
+}
+
+// restoreAclUser resets username on every node and applies rules, as
+// returned by aclListUserRules.
+func restoreAclUser(ctx context.Context, client redis.UniversalClient, username string, rules []string) error {
+	args := append([]any{"ACL", "SETUSER", username, "reset"}, toAny(rules)...)
+	return forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		return node.Do(ctx, args...).Err()
+	})
+}
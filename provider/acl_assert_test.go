@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertionFailure(t *testing.T) {
+	t.Run("holds when expectation matches", func(t *testing.T) {
+		a := &RedisAclAssertModel{
+			Command: types.StringValue("get"),
+			Args:    stringListValue("cache:1"),
+			Allowed: types.BoolValue(true),
+		}
+
+		assert.Empty(t, assertionFailure(a, true, ""))
+	})
+
+	t.Run("reports denied command expected to be allowed", func(t *testing.T) {
+		a := &RedisAclAssertModel{
+			Command: types.StringValue("get"),
+			Args:    stringListValue("cache:1"),
+			Allowed: types.BoolValue(true),
+		}
+
+		failure := assertionFailure(a, false, "no permissions to access the 'cache:1' key")
+
+		assert.Contains(t, failure, `"get cache:1" to be allowed`)
+		assert.Contains(t, failure, "no permissions")
+	})
+
+	t.Run("reports allowed command expected to be denied", func(t *testing.T) {
+		a := &RedisAclAssertModel{
+			Command: types.StringValue("flushall"),
+			Args:    types.ListNull(types.StringType),
+			Allowed: types.BoolValue(false),
+		}
+
+		assert.Contains(t, assertionFailure(a, true, ""), `"flushall" to be denied`)
+	})
+}
+
+func TestAclDryRun_Integration(t *testing.T) {
+	if os.Getenv("INTEGRATION") == "" {
+		t.Skip("set INTEGRATION=1 to run integration tests")
+	}
+	t.Run("dry runs commands and restores previous rules", func(t *testing.T) {
+		ctx := context.Background()
+		providerData, err := newRedisProviderData(&RedisProviderModel{
+			Address:  types.StringValue("localhost:6379"),
+			Username: types.StringValue("testuser"),
+			Password: types.StringValue("supersecretpassword"),
+		})
+		require.NoError(t, err)
+		defer providerData.Client.Close()
+		client := providerData.Client
+
+		require.NoError(t, client.Do(ctx, "ACL", "SETUSER", "dryrunuser", "reset", "on", "nopass", "~cache:*", "+get").Err())
+		defer client.Do(ctx, "ACL", "DELUSER", "dryrunuser")
+
+		allowed, _, err := aclDryRun(ctx, client, "dryrunuser", "get", []string{"cache:1"})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, reason, err := aclDryRun(ctx, client, "dryrunuser", "flushall", nil)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.NotEmpty(t, reason)
+
+		previous, err := aclListUserRules(ctx, client, "dryrunuser")
+		require.NoError(t, err)
+		require.NoError(t, client.Do(ctx, "ACL", "SETUSER", "dryrunuser", "+flushall").Err())
+		require.NoError(t, restoreAclUser(ctx, client, "dryrunuser", previous))
+
+		allowed, _, err = aclDryRun(ctx, client, "dryrunuser", "flushall", nil)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
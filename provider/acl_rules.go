@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/redis/go-redis/v9"
+)
+
+// privateRulesKey holds the canonical ACL LIST rules observed right after the
+// raw rules attribute was last applied.
+const privateRulesKey = "acl_rules"
+
+// privateState is implemented by the Private field of resource requests and
+// responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// splitAclRules splits an ACL rule string on spaces, keeping parenthesised
+// selectors such as "(~key +get)" together as one rule.
+func splitAclRules(line string) []string {
+	rules := []string{}
+	depth := 0
+	start := -1
+	for i, c := range line {
+		switch {
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == ' ' && depth == 0:
+			if start >= 0 {
+				rules = append(rules, line[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		rules = append(rules, line[start:])
+	}
+	return rules
+}
+
+// normalizeAclListRules drops the parts of an ACL LIST entry that are managed
+// by other attributes (the user prefix, on/off, passwords and payload flags),
+// leaving the permission rules in the server's canonical order.
+func normalizeAclListRules(username string, line string) []string {
+	rules := splitAclRules(line)
+	if len(rules) >= 2 && rules[0] == "user" && rules[1] == username {
+		rules = rules[2:]
+	}
+
+	normalized := []string{}
+	for _, rule := range rules {
+		switch {
+		case rule == "on", rule == "off", rule == "nopass", rule == "resetpass":
+		case rule == "sanitize-payload", rule == "skip-sanitize-payload":
+		case strings.HasPrefix(rule, "#"), strings.HasPrefix(rule, ">"):
+		default:
+			normalized = append(normalized, rule)
+		}
+	}
+	return normalized
+}
+
+// isPasswordOrStateRule reports whether rule is managed by password_wo or
+// enabled and therefore not allowed in the raw rules attribute.
+func isPasswordOrStateRule(rule string) bool {
+	switch rule {
+	case "on", "off", "reset", "nopass", "resetpass":
+		return true
+	}
+	return strings.HasPrefix(rule, ">") || strings.HasPrefix(rule, "<") ||
+		strings.HasPrefix(rule, "#") || strings.HasPrefix(rule, "!")
+}
+
+// aclListEntry returns the ACL LIST line of username on node, or "" when the
+// user does not exist there.
+func aclListEntry(ctx context.Context, node redis.UniversalClient, username string) (string, error) {
+	lines, err := node.Do(ctx, "ACL", "LIST").StringSlice()
+	if err != nil {
+		return "", err
+	}
+	prefix := "user " + username + " "
+	idx := slices.IndexFunc(lines, func(line string) bool { return strings.HasPrefix(line, prefix) })
+	if idx < 0 {
+		return "", nil
+	}
+	return lines[idx], nil
+}
+
+// aclListRulesFromNodes returns the normalized ACL LIST rules of username on
+// every node, with a nil entry for each node the user does not exist on.
+func aclListRulesFromNodes(ctx context.Context, client redis.UniversalClient, username string) ([][]string, error) {
+	var mu sync.Mutex
+	nodesRules := [][]string{}
+	err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		line, err := aclListEntry(ctx, node, username)
+		if err != nil {
+			return err
+		}
+		var rules []string
+		if line != "" {
+			rules = normalizeAclListRules(username, line)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		nodesRules = append(nodesRules, rules)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nodesRules, nil
+}
+
+// aclListUserRules returns the complete rules of username, passwords
+// included, as listed by the first node the user exists on. The result can
+// be passed back to ACL SETUSER after reset to restore the user.
+func aclListUserRules(ctx context.Context, client redis.UniversalClient, username string) ([]string, error) {
+	var mu sync.Mutex
+	var rules []string
+	err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		line, err := aclListEntry(ctx, node, username)
+		if err != nil || line == "" {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if rules == nil {
+			rules = splitAclRules(line)[2:]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func getPrivateRules(ctx context.Context, private privateState) ([]string, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, privateRulesKey)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+	var rules []string
+	if err := json.Unmarshal(data, &rules); err != nil {
+		diags.AddError("Failed to decode private state", err.Error())
+		return nil, diags
+	}
+	return rules, diags
+}
+
+func setPrivateRules(ctx context.Context, private privateState, rules []string) diag.Diagnostics {
+	if rules == nil {
+		return private.SetKey(ctx, privateRulesKey, nil)
+	}
+	data, err := json.Marshal(rules)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to encode private state", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, privateRulesKey, data)
+}
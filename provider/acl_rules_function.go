@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &AclRulesFunction{}
+
+func NewAclRulesFunction() function.Function {
+	return &AclRulesFunction{}
+}
+
+// AclRulesFunction renders ACL SETUSER rules with buildACLRules, the same
+// rendering redis_acl_user applies.
+type AclRulesFunction struct{}
+
+// aclRulesListAttributes are the permission lists shared by the top level of
+// the object and each of its selectors, in the order of the resource schema.
+var aclRulesListAttributes = []string{
+	"commands",
+	"excluded_commands",
+	"categories",
+	"excluded_categories",
+	"keys",
+	"readonly_keys",
+	"writeonly_keys",
+	"channels",
+}
+
+var aclRulesBoolAttributes = []string{"enabled", "nopass", "allkeys", "allchannels", "resetkeys", "resetchannels"}
+
+func aclRulesSelectorType() types.ObjectType {
+	attrTypes := map[string]attr.Type{}
+	for _, name := range aclRulesListAttributes {
+		attrTypes[name] = types.ListType{ElemType: types.StringType}
+	}
+	return types.ObjectType{AttrTypes: attrTypes}
+}
+
+// aclRulesAttributeTypes describes the object accepted by acl_rules and
+// returned by parse_acl_rules.
+func aclRulesAttributeTypes() map[string]attr.Type {
+	attrTypes := aclRulesSelectorType().AttrTypes
+	for _, name := range aclRulesBoolAttributes {
+		attrTypes[name] = types.BoolType
+	}
+	attrTypes["password_hashes"] = types.ListType{ElemType: types.StringType}
+	attrTypes["selectors"] = types.ListType{ElemType: aclRulesSelectorType()}
+	return attrTypes
+}
+
+func (f *AclRulesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "acl_rules"
+}
+
+func (f *AclRulesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Render ACL SETUSER rules",
+		Description: "Renders an object with the permission attributes of redis_acl_user as the space separated rule string the resource passes to ACL SETUSER. Attributes that are omitted take the resource defaults.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "user",
+				Description: "Object with any of enabled, nopass, password_hashes, commands, excluded_commands, categories, excluded_categories, keys, readonly_keys, writeonly_keys, channels, allkeys, allchannels, resetkeys, resetchannels, rules and selectors.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AclRulesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var user types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &user)
+	if resp.Error != nil {
+		return
+	}
+
+	m, hashes, err := aclUserModelFromValue(ctx, user.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	validateRulesConfig(m, &diags)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.Join(buildACLRules(m, hashes), " "))
+}
+
+// aclUserModelFromValue decodes the acl_rules argument into the resource
+// model and the password hashes to render. Omitted and null attributes are
+// left null so the resource defaults apply.
+func aclUserModelFromValue(ctx context.Context, value attr.Value) (*RedisAclUserResourceModel, []string, error) {
+	attrs, err := objectAttributes(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowed := append(slices.Clone(aclRulesListAttributes), aclRulesBoolAttributes...)
+	allowed = append(allowed, "password_hashes", "rules", "selectors")
+	for name := range attrs {
+		if !slices.Contains(allowed, name) {
+			return nil, nil, fmt.Errorf("unsupported attribute %q", name)
+		}
+	}
+
+	m := &RedisAclUserResourceModel{
+		Enabled:       types.BoolValue(true),
+		NoPass:        types.BoolNull(),
+		AllKeys:       types.BoolNull(),
+		AllChannels:   types.BoolNull(),
+		ResetKeys:     types.BoolNull(),
+		ResetChannels: types.BoolNull(),
+	}
+	bools := map[string]*types.Bool{
+		"enabled":       &m.Enabled,
+		"nopass":        &m.NoPass,
+		"allkeys":       &m.AllKeys,
+		"allchannels":   &m.AllChannels,
+		"resetkeys":     &m.ResetKeys,
+		"resetchannels": &m.ResetChannels,
+	}
+	for name, target := range bools {
+		v, ok := attrs[name]
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		b, ok := v.(types.Bool)
+		if !ok {
+			return nil, nil, fmt.Errorf("%s must be a bool", name)
+		}
+		*target = b
+	}
+
+	selector, err := aclSelectorModelFromAttributes(ctx, attrs)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.Commands = selector.Commands
+	m.ExcludedCommands = selector.ExcludedCommands
+	m.Categories = selector.Categories
+	m.ExcludedCategories = selector.ExcludedCategories
+	m.Keys = selector.Keys
+	m.ReadonlyKeys = selector.ReadonlyKeys
+	m.WriteonlyKeys = selector.WriteonlyKeys
+	m.Channels = selector.Channels
+
+	if m.Rules, err = stringListFromValue(ctx, "rules", attrs["rules"]); err != nil {
+		return nil, nil, err
+	}
+
+	hashList, err := stringListFromValue(ctx, "password_hashes", attrs["password_hashes"])
+	if err != nil {
+		return nil, nil, err
+	}
+	var hashes []string
+	for _, hash := range toStringList(hashList) {
+		if !isPasswordHash(hash.ValueString()) {
+			return nil, nil, fmt.Errorf("password_hashes must be 64 character hex encoded SHA256 hashes")
+		}
+		hashes = append(hashes, strings.ToLower(hash.ValueString()))
+	}
+
+	if v, ok := attrs["selectors"]; ok && !v.IsNull() && !v.IsUnknown() {
+		elements, err := listElements(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("selectors must be a list of objects")
+		}
+		for i, element := range elements {
+			selectorAttrs, err := objectAttributes(element)
+			if err != nil {
+				return nil, nil, fmt.Errorf("selectors[%d]: %w", i, err)
+			}
+			for name := range selectorAttrs {
+				if !slices.Contains(aclRulesListAttributes, name) {
+					return nil, nil, fmt.Errorf("selectors[%d]: unsupported attribute %q", i, name)
+				}
+			}
+			selector, err := aclSelectorModelFromAttributes(ctx, selectorAttrs)
+			if err != nil {
+				return nil, nil, fmt.Errorf("selectors[%d]: %w", i, err)
+			}
+			m.Selectors = append(m.Selectors, *selector)
+		}
+	}
+
+	return m, hashes, nil
+}
+
+func aclSelectorModelFromAttributes(ctx context.Context, attrs map[string]attr.Value) (*RedisAclSelectorModel, error) {
+	s := &RedisAclSelectorModel{}
+	lists := []*types.List{&s.Commands, &s.ExcludedCommands, &s.Categories, &s.ExcludedCategories, &s.Keys, &s.ReadonlyKeys, &s.WriteonlyKeys, &s.Channels}
+	for i, name := range aclRulesListAttributes {
+		list, err := stringListFromValue(ctx, name, attrs[name])
+		if err != nil {
+			return nil, err
+		}
+		*lists[i] = list
+	}
+	return s, nil
+}
+
+// objectAttributes returns the attributes of an object or map value.
+func objectAttributes(value attr.Value) (map[string]attr.Value, error) {
+	switch v := value.(type) {
+	case types.Object:
+		if v.IsNull() || v.IsUnknown() {
+			return map[string]attr.Value{}, nil
+		}
+		return v.Attributes(), nil
+	case types.Map:
+		if v.IsNull() || v.IsUnknown() {
+			return map[string]attr.Value{}, nil
+		}
+		return v.Elements(), nil
+	default:
+		return nil, fmt.Errorf("expected an object")
+	}
+}
+
+// listElements returns the elements of a list, tuple or set value, since a
+// literal such as ["a", "b"] reaches a dynamic parameter as a tuple.
+func listElements(value attr.Value) ([]attr.Value, error) {
+	switch v := value.(type) {
+	case types.List:
+		return v.Elements(), nil
+	case types.Tuple:
+		return v.Elements(), nil
+	case types.Set:
+		return v.Elements(), nil
+	default:
+		return nil, fmt.Errorf("expected a list")
+	}
+}
+
+// stringListFromValue converts an optional attribute value into a list of
+// strings, returning a null list when it is omitted or null.
+func stringListFromValue(ctx context.Context, name string, value attr.Value) (types.List, error) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return types.ListNull(types.StringType), nil
+	}
+	elements, err := listElements(value)
+	if err != nil {
+		return types.ListNull(types.StringType), fmt.Errorf("%s must be a list of strings", name)
+	}
+	items := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, ok := element.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return types.ListNull(types.StringType), fmt.Errorf("%s must be a list of strings", name)
+		}
+		items = append(items, s.ValueString())
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, items)
+	if diags.HasError() {
+		return types.ListNull(types.StringType), fmt.Errorf("failed to convert %s", name)
+	}
+	return list, nil
+}
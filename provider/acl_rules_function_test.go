@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tupleValue(values ...string) types.Tuple {
+	elementTypes := make([]attr.Type, len(values))
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elementTypes[i] = types.StringType
+		elements[i] = types.StringValue(v)
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+// objectLiteral builds the value Terraform passes for an object literal.
+func objectLiteral(values map[string]attr.Value) types.Object {
+	attrTypes := map[string]attr.Type{}
+	for name, v := range values {
+		attrTypes[name] = v.Type(context.Background())
+	}
+	return types.ObjectValueMust(attrTypes, values)
+}
+
+func runAclRulesFunction(t *testing.T, user attr.Value) (string, *function.FuncError) {
+	t.Helper()
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewAclRulesFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(user)}),
+	}, resp)
+
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	result, ok := resp.Result.Value().(types.String)
+	require.True(t, ok)
+	return result.ValueString(), nil
+}
+
+func TestAclRulesFunction_Metadata(t *testing.T) {
+	t.Run("sets function name", func(t *testing.T) {
+		resp := &function.MetadataResponse{}
+
+		NewAclRulesFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+		assert.Equal(t, "acl_rules", resp.Name)
+	})
+}
+
+func TestAclRulesFunction_Run(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+
+	t.Run("renders permissions with resource defaults", func(t *testing.T) {
+		rules, err := runAclRulesFunction(t, objectLiteral(map[string]attr.Value{
+			"categories": tupleValue("read"),
+			"keys":       tupleValue("app:*"),
+		}))
+
+		require.Nil(t, err)
+		assert.Equal(t, "reset on ~app:* +@read", rules)
+	})
+
+	t.Run("renders flags, passwords and selectors", func(t *testing.T) {
+		rules, err := runAclRulesFunction(t, objectLiteral(map[string]attr.Value{
+			"enabled":         types.BoolValue(false),
+			"password_hashes": tupleValue(strings.ToUpper(hash)),
+			"allchannels":     types.BoolValue(true),
+			"commands":        tupleValue("get"),
+			"selectors": types.TupleValueMust(
+				[]attr.Type{types.ObjectType{AttrTypes: map[string]attr.Type{"keys": types.TupleType{ElemTypes: []attr.Type{types.StringType}}}}},
+				[]attr.Value{objectLiteral(map[string]attr.Value{"keys": tupleValue("logs:*")})},
+			),
+		}))
+
+		require.Nil(t, err)
+		assert.Equal(t, "reset off #"+hash+" allchannels +get (~logs:*)", rules)
+	})
+
+	t.Run("renders raw rules", func(t *testing.T) {
+		rules, err := runAclRulesFunction(t, objectLiteral(map[string]attr.Value{
+			"nopass": types.BoolValue(true),
+			"rules":  tupleValue("~*", "+@all"),
+		}))
+
+		require.Nil(t, err)
+		assert.Equal(t, "reset on nopass ~* +@all", rules)
+	})
+
+	t.Run("accepts null attributes", func(t *testing.T) {
+		rules, err := runAclRulesFunction(t, objectLiteral(map[string]attr.Value{
+			"keys":     types.ListNull(types.StringType),
+			"channels": tupleValue("events"),
+		}))
+
+		require.Nil(t, err)
+		assert.Equal(t, "reset on &events", rules)
+	})
+
+	t.Run("rejects unsupported attributes", func(t *testing.T) {
+		_, err := runAclRulesFunction(t, objectLiteral(map[string]attr.Value{
+			"key": tupleValue("app:*"),
+		}))
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `unsupported attribute "key"`)
+	})
+
+	t.Run("rejects invalid password hashes", func(t *testing.T) {
+		_, err := runAclRulesFunction(t, objectLiteral(map[string]attr.Value{
+			"password_hashes": tupleValue("secret"),
+		}))
+
+		require.NotNil(t, err)
+	})
+
+	t.Run("rejects rules combined with permissions", func(t *testing.T) {
+		_, err := runAclRulesFunction(t, objectLiteral(map[string]attr.Value{
+			"keys":  tupleValue("app:*"),
+			"rules": tupleValue("+get"),
+		}))
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "keys cannot be set together with rules")
+	})
+
+	t.Run("rejects non-object arguments", func(t *testing.T) {
+		_, err := runAclRulesFunction(t, types.StringValue("on"))
+
+		require.NotNil(t, err)
+	})
+}
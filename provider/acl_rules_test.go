@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePrivateState map[string][]byte
+
+func (f fakePrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return f[key], nil
+}
+
+func (f fakePrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(f, key)
+		return nil
+	}
+	f[key] = value
+	return nil
+}
+
+func TestSplitAclRules(t *testing.T) {
+	t.Run("splits on spaces", func(t *testing.T) {
+		assert.Equal(t, []string{"+@all", "-flushall", "+flushall|async"}, splitAclRules("+@all -flushall +flushall|async"))
+	})
+
+	t.Run("keeps selectors together", func(t *testing.T) {
+		assert.Equal(t, []string{"~app:*", "(~cache:* %R~ro:* +get)", "+ping"}, splitAclRules("~app:* (~cache:* %R~ro:* +get) +ping"))
+	})
+
+	t.Run("ignores repeated spaces", func(t *testing.T) {
+		assert.Equal(t, []string{"on", "~*"}, splitAclRules(" on  ~* "))
+	})
+
+	t.Run("returns empty list for empty line", func(t *testing.T) {
+		assert.Equal(t, []string{}, splitAclRules(""))
+	})
+}
+
+func TestNormalizeAclListRules(t *testing.T) {
+	t.Run("strips user prefix, state and passwords", func(t *testing.T) {
+		line := "user app on sanitize-payload #5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8 ~app:* resetchannels -@all +get (~cache:* +set)"
+
+		assert.Equal(t, []string{"~app:*", "resetchannels", "-@all", "+get", "(~cache:* +set)"}, normalizeAclListRules("app", line))
+	})
+
+	t.Run("strips nopass", func(t *testing.T) {
+		assert.Equal(t, []string{"~*", "&*", "+@all"}, normalizeAclListRules("default", "user default on nopass ~* &* +@all"))
+	})
+}
+
+func TestSplitAclRules_ListEntry(t *testing.T) {
+	t.Run("keeps passwords for restoring a user", func(t *testing.T) {
+		rules := splitAclRules("user app on #abc ~app:* resetchannels -@all +get")[2:]
+
+		assert.Equal(t, []string{"on", "#abc", "~app:*", "resetchannels", "-@all", "+get"}, rules)
+	})
+}
+
+func TestIsPasswordOrStateRule(t *testing.T) {
+	for _, rule := range []string{"on", "off", "reset", "nopass", "resetpass", ">secret", "<secret", "#abc", "!abc"} {
+		assert.True(t, isPasswordOrStateRule(rule), rule)
+	}
+	for _, rule := range []string{"+@all", "-flushall", "~*", "&*", "resetkeys", "allkeys", "(~a +get)"} {
+		assert.False(t, isPasswordOrStateRule(rule), rule)
+	}
+}
+
+func TestPrivateRules(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("round trips rules", func(t *testing.T) {
+		private := fakePrivateState{}
+		require.False(t, setPrivateRules(ctx, private, []string{"+@all", "-flushall"}).HasError())
+
+		rules, diags := getPrivateRules(ctx, private)
+		require.False(t, diags.HasError())
+		assert.Equal(t, []string{"+@all", "-flushall"}, rules)
+	})
+
+	t.Run("removes rules when nil", func(t *testing.T) {
+		private := fakePrivateState{privateRulesKey: []byte(`["+@all"]`)}
+		require.False(t, setPrivateRules(ctx, private, nil).HasError())
+
+		rules, diags := getPrivateRules(ctx, private)
+		require.False(t, diags.HasError())
+		assert.Nil(t, rules)
+	})
+
+	t.Run("reports invalid data", func(t *testing.T) {
+		private := fakePrivateState{privateRulesKey: []byte(`{}`)}
+
+		_, diags := getPrivateRules(ctx, private)
+		assert.True(t, diags.HasError())
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
+)
+
+// aclNameList is a categories or commands list together with the attribute
+// path diagnostics about its elements are reported at.
+type aclNameList struct {
+	path       path.Path
+	list       types.List
+	categories bool
+}
+
+// aclNameLists returns every categories and commands list of the model,
+// including those of its selectors.
+func aclNameLists(m *RedisAclUserResourceModel) []aclNameList {
+	lists := []aclNameList{
+		{path.Root("categories"), m.Categories, true},
+		{path.Root("excluded_categories"), m.ExcludedCategories, true},
+		{path.Root("commands"), m.Commands, false},
+		{path.Root("excluded_commands"), m.ExcludedCommands, false},
+	}
+	for i, selector := range m.Selectors {
+		selectorPath := path.Root("selector").AtListIndex(i)
+		lists = append(lists,
+			aclNameList{selectorPath.AtName("categories"), selector.Categories, true},
+			aclNameList{selectorPath.AtName("excluded_categories"), selector.ExcludedCategories, true},
+			aclNameList{selectorPath.AtName("commands"), selector.Commands, false},
+			aclNameList{selectorPath.AtName("excluded_commands"), selector.ExcludedCommands, false},
+		)
+	}
+	return lists
+}
+
+// aclCommandNames returns the distinct top-level commands referenced by the
+// model, with subcommands such as "config|get" reduced to their parent.
+func aclCommandNames(m *RedisAclUserResourceModel) []string {
+	names := []string{}
+	for _, l := range aclNameLists(m) {
+		if l.categories {
+			continue
+		}
+		for _, v := range toStringList(l.list) {
+			if v.IsUnknown() || v.IsNull() || v.ValueString() == "all" {
+				continue
+			}
+			parent, _, _ := strings.Cut(strings.ToLower(v.ValueString()), "|")
+			if !slices.Contains(names, parent) {
+				names = append(names, parent)
+			}
+		}
+	}
+	return names
+}
+
+// aclCommandInfo looks up commands with COMMAND INFO and returns the known
+// ones mapped to their subcommands. Servers that do not report subcommands
+// (Redis 6) map every command to nil.
+func aclCommandInfo(ctx context.Context, client redis.UniversalClient, names []string) (map[string][]string, error) {
+	known := map[string][]string{}
+	if len(names) == 0 {
+		return known, nil
+	}
+	args := append([]any{"COMMAND", "INFO"}, toAny(names)...)
+	reply, err := client.Do(ctx, args...).Slice()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range reply {
+		info, ok := entry.([]any)
+		if !ok || len(info) == 0 {
+			continue
+		}
+		name, ok := info[0].(string)
+		if !ok {
+			continue
+		}
+		var subcommands []string
+		if len(info) > 9 {
+			if subs, ok := info[9].([]any); ok {
+				subcommands = []string{}
+				for _, sub := range subs {
+					if subInfo, ok := sub.([]any); ok && len(subInfo) > 0 {
+						if subName, ok := subInfo[0].(string); ok {
+							subcommands = append(subcommands, strings.ToLower(subName))
+						}
+					}
+				}
+			}
+		}
+		known[strings.ToLower(name)] = subcommands
+	}
+	return known, nil
+}
+
+// validateAclNames reports categories missing from ACL CAT and commands
+// unknown to COMMAND INFO. A "command|arg" rule is only checked against the
+// subcommands when the command has any; otherwise the argument is a first
+// argument, which Redis accepts for any command.
+func validateAclNames(m *RedisAclUserResourceModel, categories []string, commands map[string][]string, diags *diag.Diagnostics) {
+	for _, l := range aclNameLists(m) {
+		for i, v := range toStringList(l.list) {
+			if v.IsUnknown() || v.IsNull() || v.ValueString() == "all" {
+				continue
+			}
+			name := strings.ToLower(v.ValueString())
+			elementPath := l.path.AtListIndex(i)
+
+			if l.categories {
+				if !slices.Contains(categories, name) {
+					diags.AddAttributeError(elementPath, "Unknown ACL category", fmt.Sprintf("category %q is not listed by ACL CAT on this server", v.ValueString()))
+				}
+				continue
+			}
+
+			parent, _, hasSub := strings.Cut(name, "|")
+			subcommands, ok := commands[parent]
+			if !ok {
+				diags.AddAttributeError(elementPath, "Unknown command", fmt.Sprintf("command %q is not known to this server", parent))
+				continue
+			}
+			if hasSub && len(subcommands) > 0 && !slices.Contains(subcommands, name) {
+				diags.AddAttributeError(elementPath, "Unknown subcommand", fmt.Sprintf("%q is not a subcommand of %q on this server", v.ValueString(), parent))
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stringListValue(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestAclCommandNames(t *testing.T) {
+	t.Run("collects distinct parent commands", func(t *testing.T) {
+		model := &RedisAclUserResourceModel{
+			Commands:         stringListValue("GET", "config|get", "all"),
+			ExcludedCommands: stringListValue("config|set"),
+			Categories:       stringListValue("read"),
+			Selectors: []RedisAclSelectorModel{
+				{Commands: stringListValue("set")},
+			},
+		}
+
+		assert.Equal(t, []string{"get", "config", "set"}, aclCommandNames(model))
+	})
+
+	t.Run("skips unknown lists", func(t *testing.T) {
+		model := &RedisAclUserResourceModel{Commands: types.ListUnknown(types.StringType)}
+
+		assert.Empty(t, aclCommandNames(model))
+	})
+}
+
+func TestValidateAclNames(t *testing.T) {
+	categories := []string{"read", "write", "dangerous"}
+	commands := map[string][]string{
+		"get":    {},
+		"select": {},
+		"config": {"config|get", "config|set"},
+		"ping":   nil,
+	}
+
+	errorPaths := func(diags diag.Diagnostics) []path.Path {
+		paths := []path.Path{}
+		for _, d := range diags.Errors() {
+			paths = append(paths, d.(diag.DiagnosticWithPath).Path())
+		}
+		return paths
+	}
+
+	t.Run("accepts known names", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validateAclNames(&RedisAclUserResourceModel{
+			Categories:         stringListValue("read", "all"),
+			ExcludedCategories: stringListValue("dangerous"),
+			Commands:           stringListValue("GET", "config|get", "select|0", "ping|x", "all"),
+		}, categories, commands, &diags)
+
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	t.Run("reports unknown categories and commands", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validateAclNames(&RedisAclUserResourceModel{
+			Categories:       stringListValue("read", "raed"),
+			ExcludedCommands: stringListValue("flushal", "config|gett"),
+			Selectors: []RedisAclSelectorModel{
+				{Categories: stringListValue("wirte")},
+			},
+		}, categories, commands, &diags)
+
+		assert.Equal(t, []path.Path{
+			path.Root("categories").AtListIndex(1),
+			path.Root("excluded_commands").AtListIndex(0),
+			path.Root("excluded_commands").AtListIndex(1),
+			path.Root("selector").AtListIndex(0).AtName("categories").AtListIndex(0),
+		}, errorPaths(diags))
+	})
+}
+
+func TestAclCommandInfo_Integration(t *testing.T) {
+	if os.Getenv("INTEGRATION") == "" {
+		t.Skip("set INTEGRATION=1 to run integration tests")
+	}
+	t.Run("reports known commands with their subcommands", func(t *testing.T) {
+		providerData, err := newRedisProviderData(&RedisProviderModel{
+			Address:  types.StringValue("localhost:6379"),
+			Username: types.StringValue("testuser"),
+			Password: types.StringValue("supersecretpassword"),
+		})
+		require.NoError(t, err)
+		defer providerData.Client.Close()
+
+		commands, err := aclCommandInfo(context.Background(), providerData.Client, []string{"get", "config", "flushal"})
+
+		require.NoError(t, err)
+		assert.Contains(t, commands, "get")
+		assert.Contains(t, commands["config"], "config|get")
+		assert.NotContains(t, commands, "flushal")
+	})
+}
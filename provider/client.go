@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisProviderData is handed to resources, data sources and ephemeral
+// resources. Client is shared by all of them so connections are pooled for
+// the lifetime of the provider instead of being opened per command. In
+// cluster mode it is a *clusterClient, which also pools the connections to
+// each node.
+type RedisProviderData struct {
+	Model  *RedisProviderModel
+	Client redis.UniversalClient
+}
+
+func (d *RedisProviderData) redisClient() (redis.UniversalClient, error) {
+	if d == nil || d.Client == nil {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+	return d.Client, nil
+}
+
+func newRedisProviderData(m *RedisProviderModel) (*RedisProviderData, error) {
+	client, err := newRedisClient(m)
+	if err != nil {
+		return nil, err
+	}
+	if cluster, ok := client.(*redis.ClusterClient); ok {
+		client = newClusterClient(cluster)
+	}
+	return &RedisProviderData{Model: m, Client: client}, nil
+}
+
+// newRedisClient builds a client for the connection described by the provider
+// configuration. When a sentinel block is present the client follows the
+// current master reported by Sentinel instead of connecting to address, and
+// when a cluster block is present a cluster client is returned. Otherwise url
+// provides the base options, overridden by any attribute set explicitly.
+func newRedisClient(m *RedisProviderModel) (redis.UniversalClient, error) {
+	tlsConfig, err := buildTLSConfig(m.TLS)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := connectionSettingsFromModel(m)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Sentinel != nil {
+		var sentinelAddrs []string
+		for _, addr := range toStringList(m.Sentinel.Addresses) {
+			sentinelAddrs = append(sentinelAddrs, addr.ValueString())
+		}
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       m.Sentinel.MasterName.ValueString(),
+			SentinelAddrs:    sentinelAddrs,
+			SentinelUsername: m.Sentinel.Username.ValueString(),
+			SentinelPassword: m.Sentinel.Password.ValueString(),
+			Username:         m.Username.ValueString(),
+			Password:         m.Password.ValueString(),
+			DB:               int(m.Database.ValueInt64()),
+			TLSConfig:        tlsConfig,
+			PoolSize:         int(m.PoolSize.ValueInt64()),
+			MinIdleConns:     int(m.MinIdleConns.ValueInt64()),
+			DialTimeout:      settings.DialTimeout,
+			ReadTimeout:      settings.ReadTimeout,
+			WriteTimeout:     settings.WriteTimeout,
+			MaxRetries:       settings.MaxRetries,
+			MinRetryBackoff:  settings.MinRetryBackoff,
+			MaxRetryBackoff:  settings.MaxRetryBackoff,
+		}), nil
+	}
+
+	if m.Cluster != nil {
+		var clusterAddrs []string
+		for _, addr := range toStringList(m.Cluster.Addresses) {
+			clusterAddrs = append(clusterAddrs, addr.ValueString())
+		}
+		if len(clusterAddrs) == 0 && m.Address.ValueString() != "" {
+			clusterAddrs = []string{m.Address.ValueString()}
+		}
+		if len(clusterAddrs) == 0 {
+			return nil, fmt.Errorf("address or cluster addresses must be set in cluster mode")
+		}
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:           clusterAddrs,
+			Username:        m.Username.ValueString(),
+			Password:        m.Password.ValueString(),
+			TLSConfig:       tlsConfig,
+			PoolSize:        int(m.PoolSize.ValueInt64()),
+			MinIdleConns:    int(m.MinIdleConns.ValueInt64()),
+			DialTimeout:     settings.DialTimeout,
+			ReadTimeout:     settings.ReadTimeout,
+			WriteTimeout:    settings.WriteTimeout,
+			MaxRetries:      settings.MaxRetries,
+			MinRetryBackoff: settings.MinRetryBackoff,
+			MaxRetryBackoff: settings.MaxRetryBackoff,
+		}), nil
+	}
+
+	options, err := resolveStandaloneOptions(m)
+	if err != nil {
+		return nil, err
+	}
+	if v := m.PoolSize.ValueInt64(); v > 0 {
+		options.PoolSize = int(v)
+	}
+	if v := m.MinIdleConns.ValueInt64(); v > 0 {
+		options.MinIdleConns = int(v)
+	}
+	if settings.DialTimeout > 0 {
+		options.DialTimeout = settings.DialTimeout
+	}
+	if settings.ReadTimeout > 0 {
+		options.ReadTimeout = settings.ReadTimeout
+	}
+	if settings.WriteTimeout > 0 {
+		options.WriteTimeout = settings.WriteTimeout
+	}
+	if settings.MaxRetries != 0 {
+		options.MaxRetries = settings.MaxRetries
+	}
+	if settings.MinRetryBackoff > 0 {
+		options.MinRetryBackoff = settings.MinRetryBackoff
+	}
+	if settings.MaxRetryBackoff > 0 {
+		options.MaxRetryBackoff = settings.MaxRetryBackoff
+	}
+
+	return redis.NewClient(options), nil
+}
+
+// resolveStandaloneOptions resolves the server, database, credentials and TLS
+// settings of a standalone connection: url provides the base options,
+// overridden by any attribute set explicitly. It is shared by the client and
+// redis_connection_info so both describe the same server.
+func resolveStandaloneOptions(m *RedisProviderModel) (*redis.Options, error) {
+	options := &redis.Options{}
+	if !m.URL.IsNull() {
+		var err error
+		if options, err = redis.ParseURL(m.URL.ValueString()); err != nil {
+			return nil, err
+		}
+	}
+	if v := m.Network.ValueString(); v != "" {
+		options.Network = v
+	}
+	if v := m.Address.ValueString(); v != "" {
+		options.Addr = v
+	}
+	if options.Network == "unix" {
+		if v := m.SocketPath.ValueString(); v != "" {
+			options.Addr = v
+		}
+	}
+	if options.Addr == "" {
+		return nil, fmt.Errorf("address, socket_path or url must be set when sentinel and cluster are not configured")
+	}
+	if !m.Database.IsNull() {
+		options.DB = int(m.Database.ValueInt64())
+	}
+	if v := m.Username.ValueString(); v != "" {
+		options.Username = v
+	}
+	if v := m.Password.ValueString(); v != "" {
+		options.Password = v
+	}
+	if m.TLS != nil {
+		tlsConfig, err := buildTLSConfig(m.TLS)
+		if err != nil {
+			return nil, err
+		}
+		options.TLSConfig = tlsConfig
+	}
+	return options, nil
+}
+
+// connectionSettings holds the timeout and retry options shared by every
+// client type. Zero values leave the go-redis defaults in place.
+type connectionSettings struct {
+	DialTimeout     time.Duration
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	MaxRetries      int
+	MinRetryBackoff time.Duration
+	MaxRetryBackoff time.Duration
+}
+
+func connectionSettingsFromModel(m *RedisProviderModel) (connectionSettings, error) {
+	var settings connectionSettings
+	durations := []struct {
+		name  string
+		value types.String
+		out   *time.Duration
+	}{
+		{"dial_timeout", m.DialTimeout, &settings.DialTimeout},
+		{"read_timeout", m.ReadTimeout, &settings.ReadTimeout},
+		{"write_timeout", m.WriteTimeout, &settings.WriteTimeout},
+		{"min_retry_backoff", m.MinRetryBackoff, &settings.MinRetryBackoff},
+		{"max_retry_backoff", m.MaxRetryBackoff, &settings.MaxRetryBackoff},
+	}
+	for _, d := range durations {
+		if d.value.IsNull() || d.value.IsUnknown() {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value.ValueString())
+		if err != nil {
+			return settings, fmt.Errorf("invalid %s: %w", d.name, err)
+		}
+		if duration < 0 {
+			return settings, fmt.Errorf("invalid %s: must not be negative", d.name)
+		}
+		*d.out = duration
+	}
+
+	if !m.MaxRetries.IsNull() {
+		switch retries := m.MaxRetries.ValueInt64(); {
+		case retries < 0:
+			return settings, fmt.Errorf("invalid max_retries: must not be negative")
+		case retries == 0:
+			// go-redis treats 0 as "use the default", -1 disables retries.
+			settings.MaxRetries = -1
+		default:
+			settings.MaxRetries = int(retries)
+		}
+	}
+
+	if settings.MinRetryBackoff > 0 && settings.MaxRetryBackoff > 0 && settings.MinRetryBackoff > settings.MaxRetryBackoff {
+		return settings, fmt.Errorf("min_retry_backoff must not be greater than max_retry_backoff")
+	}
+
+	return settings, nil
+}
+
+// clusterClient is the cluster client shared through RedisProviderData. It
+// keeps a pooled client per node for the commands forEachNode sends to every
+// node, and closes them together with the cluster client.
+type clusterClient struct {
+	*redis.ClusterClient
+	nodes *nodeClients
+}
+
+func newClusterClient(cluster *redis.ClusterClient) *clusterClient {
+	return &clusterClient{ClusterClient: cluster, nodes: newNodeClients(cluster.Options())}
+}
+
+func (c *clusterClient) Close() error {
+	return errors.Join(c.nodes.Close(), c.ClusterClient.Close())
+}
+
+// nodeClients caches one client per cluster node address.
+type nodeClients struct {
+	mu      sync.Mutex
+	options *redis.ClusterOptions
+	clients map[string]*redis.Client
+}
+
+func newNodeClients(options *redis.ClusterOptions) *nodeClients {
+	return &nodeClients{options: options, clients: map[string]*redis.Client{}}
+}
+
+func (n *nodeClients) get(addr string) *redis.Client {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	client, ok := n.clients[addr]
+	if !ok {
+		client = redis.NewClient(clusterNodeOptions(n.options, addr))
+		n.clients[addr] = client
+	}
+	return client
+}
+
+func (n *nodeClients) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var errs []error
+	for addr, client := range n.clients {
+		errs = append(errs, client.Close())
+		delete(n.clients, addr)
+	}
+	return errors.Join(errs...)
+}
+
+// forEachNode runs fn against every node ACL commands must be sent to. ACL
+// rules are local to each node in Redis Cluster, so cluster clients visit
+// every primary and replica listed by CLUSTER NODES, including primaries that
+// own no slots yet; any other client is visited once. The cluster client of
+// the provider reuses its node clients across calls.
+func forEachNode(ctx context.Context, client redis.UniversalClient, fn func(ctx context.Context, node redis.UniversalClient) error) error {
+	var cluster *clusterClient
+	switch c := client.(type) {
+	case *clusterClient:
+		cluster = c
+	case *redis.ClusterClient:
+		cluster = newClusterClient(c)
+		defer cluster.nodes.Close()
+	default:
+		return fn(ctx, client)
+	}
+
+	reply, err := cluster.ClusterNodes(ctx).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list cluster nodes: %w", err)
+	}
+	addrs, err := parseClusterNodes(reply)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(addrs))
+	for i, addr := range addrs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(ctx, cluster.nodes.get(addr)); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", addr, err)
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// parseClusterNodes returns the address of every node in a CLUSTER NODES
+// reply that is reachable. Nodes flagged fail or handshake, or without an
+// address, cannot be sent commands and are skipped; a suspected failure
+// (fail?) is still attempted so a real outage is reported rather than hidden.
+func parseClusterNodes(reply string) ([]string, error) {
+	addrs := []string{}
+	for _, line := range strings.Split(strings.TrimSpace(reply), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("unexpected CLUSTER NODES line %q", line)
+		}
+		flags := strings.Split(fields[2], ",")
+		if slices.Contains(flags, "fail") || slices.Contains(flags, "handshake") || slices.Contains(flags, "noaddr") {
+			continue
+		}
+		// The address is ip:port@cport, optionally followed by ,hostname.
+		addr, _, _ := strings.Cut(fields[1], ",")
+		// IPv6 addresses are not bracketed, so split at the last colon.
+		addr, _, _ = strings.Cut(addr, "@")
+		i := strings.LastIndexByte(addr, ':')
+		if i <= 0 || i == len(addr)-1 {
+			continue
+		}
+		addrs = append(addrs, net.JoinHostPort(addr[:i], addr[i+1:]))
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("CLUSTER NODES returned no reachable nodes")
+	}
+	return addrs, nil
+}
+
+// clusterNodeOptions returns the options of a client for a single cluster
+// node, using the credentials, TLS and timeout settings of the cluster client.
+func clusterNodeOptions(opt *redis.ClusterOptions, addr string) *redis.Options {
+	return &redis.Options{
+		Addr:            addr,
+		Protocol:        opt.Protocol,
+		Username:        opt.Username,
+		Password:        opt.Password,
+		TLSConfig:       opt.TLSConfig,
+		DialTimeout:     opt.DialTimeout,
+		ReadTimeout:     opt.ReadTimeout,
+		WriteTimeout:    opt.WriteTimeout,
+		MaxRetries:      opt.MaxRetries,
+		MinRetryBackoff: opt.MinRetryBackoff,
+		MaxRetryBackoff: opt.MaxRetryBackoff,
+		PoolSize:        opt.PoolSize,
+		MinIdleConns:    opt.MinIdleConns,
+	}
+}
+
+// aclGetUser returns the ACL GETUSER reply of the first node the user exists
+// on, or redis.Nil when it exists on none.
+func aclGetUser(ctx context.Context, client redis.UniversalClient, username string) (map[any]any, error) {
+	nodesData, err := aclGetUserFromNodes(ctx, client, username)
+	if err != nil {
+		return nil, err
+	}
+	for _, aclData := range nodesData {
+		if aclData != nil {
+			return aclData, nil
+		}
+	}
+	return nil, redis.Nil
+}
+
+// aclGetUserFromNodes returns the ACL GETUSER reply of every node, with a nil
+// entry for each node the user does not exist on.
+func aclGetUserFromNodes(ctx context.Context, client redis.UniversalClient, username string) ([]map[any]any, error) {
+	var mu sync.Mutex
+	nodesData := []map[any]any{}
+	err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		var aclData map[any]any
+		res, err := node.Do(ctx, "ACL", "GETUSER", username).Result()
+		switch {
+		case errors.Is(err, redis.Nil):
+		case err != nil:
+			return err
+		default:
+			var ok bool
+			if aclData, ok = res.(map[any]any); !ok {
+				return fmt.Errorf("unexpected response format from Redis")
+			}
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		nodesData = append(nodesData, aclData)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nodesData, nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &KeySlotFunction{}
+
+func NewKeySlotFunction() function.Function {
+	return &KeySlotFunction{}
+}
+
+// KeySlotFunction computes the Redis Cluster hash slot of a key without a
+// server connection.
+type KeySlotFunction struct{}
+
+// clusterSlots is the number of hash slots in a Redis Cluster.
+const clusterSlots = 16384
+
+func (f *KeySlotFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "key_slot"
+}
+
+func (f *KeySlotFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the cluster hash slot of a key",
+		Description: "Returns the Redis Cluster hash slot of a key, as CLUSTER KEYSLOT does: the CRC16 of the key modulo 16384. When the key contains a non-empty hash tag such as {user1000}, only the tag is hashed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "Key to compute the hash slot of.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *KeySlotFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+	resp.Error = req.Arguments.Get(ctx, &key)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, int64(keySlot(key)))
+}
+
+// keySlot returns the hash slot of key, hashing only its hash tag when it
+// has one.
+func keySlot(key string) int {
+	return int(crc16([]byte(hashTag(key))) % clusterSlots)
+}
+
+// hashTag returns the part of key between the first '{' and the first '}'
+// after it, or the whole key when there is no such part or it is empty.
+func hashTag(key string) string {
+	start := strings.IndexByte(key, '{')
+	if start < 0 {
+		return key
+	}
+	end := strings.IndexByte(key[start+1:], '}')
+	if end <= 0 {
+		return key
+	}
+	return key[start+1 : start+1+end]
+}
+
+// crc16 implements CRC-16/XMODEM (polynomial 0x1021, initial value 0), the
+// checksum Redis Cluster uses for key hashing.
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
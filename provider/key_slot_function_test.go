@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySlotFunction_Metadata(t *testing.T) {
+	t.Run("sets function name", func(t *testing.T) {
+		resp := &function.MetadataResponse{}
+
+		NewKeySlotFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+		assert.Equal(t, "key_slot", resp.Name)
+	})
+}
+
+func TestKeySlotFunction_Run(t *testing.T) {
+	t.Run("returns the slot of the key", func(t *testing.T) {
+		resp := &function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+
+		NewKeySlotFunction().Run(context.Background(), function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("foo")}),
+		}, resp)
+
+		require.Nil(t, resp.Error)
+		assert.Equal(t, types.Int64Value(12182), resp.Result.Value())
+	})
+}
+
+func TestCRC16(t *testing.T) {
+	t.Run("matches the XMODEM check value", func(t *testing.T) {
+		assert.Equal(t, uint16(0x31c3), crc16([]byte("123456789")))
+	})
+}
+
+func TestKeySlot(t *testing.T) {
+	tests := []struct {
+		key  string
+		slot int
+	}{
+		{"", 0},
+		{"foo", 12182},
+		{"bar", 5061},
+		{"hello", 866},
+		{"{user1000}.following", keySlot("user1000")},
+		{"foo{{bar}}zap", keySlot("{bar")},
+		{"foo{bar}{zap}", keySlot("bar")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.slot, keySlot(tt.key))
+		})
+	}
+}
+
+func TestHashTag(t *testing.T) {
+	t.Run("uses the first tag", func(t *testing.T) {
+		assert.Equal(t, "user1000", hashTag("{user1000}.following"))
+		assert.Equal(t, "bar", hashTag("foo{bar}{zap}"))
+	})
+
+	t.Run("ignores empty and unterminated tags", func(t *testing.T) {
+		assert.Equal(t, "foo{}{bar}", hashTag("foo{}{bar}"))
+		assert.Equal(t, "foo{bar", hashTag("foo{bar"))
+	})
+
+	t.Run("stops at the first closing brace", func(t *testing.T) {
+		assert.Equal(t, "{bar", hashTag("foo{{bar}}zap"))
+	})
+}
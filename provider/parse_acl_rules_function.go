@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseAclRulesFunction{}
+
+func NewParseAclRulesFunction() function.Function {
+	return &ParseAclRulesFunction{}
+}
+
+// ParseAclRulesFunction parses an ACL rule string into the object accepted by
+// acl_rules, using the parsers redis_acl_user reads ACL GETUSER replies with.
+type ParseAclRulesFunction struct{}
+
+func (f *ParseAclRulesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_acl_rules"
+}
+
+func (f *ParseAclRulesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse ACL rules",
+		Description: "Parses a space separated ACL rule string, optionally prefixed with 'user <name>' as in an aclfile, into an object with the permission attributes of redis_acl_user. Rules are applied in order the way ACL SETUSER applies them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "rules",
+				Description: "ACL rules, such as 'on #<hash> ~app:* +@read'.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: aclRulesAttributeTypes(),
+		},
+	}
+}
+
+func (f *ParseAclRulesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rules string
+	resp.Error = req.Arguments.Get(ctx, &rules)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseAclRuleString(rules)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	m := &RedisAclUserResourceModel{}
+	_ = loadAclMapIntoState(ctx, parsed.aclMap(), m, &diags)
+	m.ResetKeys = types.BoolValue(parsed.resetKeys)
+	m.ResetChannels = types.BoolValue(parsed.resetChannels)
+
+	value := aclRulesObjectValue(ctx, m, parsePasswordHashesFromAclMap(parsed.aclMap()), &diags)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, value)
+}
+
+// parsedAclRules is the user an ACL rule string describes after applying its
+// rules in order, with the permission rules kept in their ACL GETUSER form.
+type parsedAclRules struct {
+	enabled       bool
+	nopass        bool
+	passwords     []string
+	allKeys       bool
+	allChannels   bool
+	resetKeys     bool
+	resetChannels bool
+	permissions   aclPermissionRules
+	selectors     []aclPermissionRules
+}
+
+type aclPermissionRules struct {
+	commands []string
+	keys     []string
+	channels []string
+}
+
+// add records a command, key or channel rule and reports whether rule is one.
+func (p *aclPermissionRules) add(rule string) bool {
+	switch {
+	case strings.EqualFold(rule, "allcommands"):
+		p.commands = append(p.commands, "+@all")
+	case strings.EqualFold(rule, "nocommands"):
+		p.commands = append(p.commands, "-@all")
+	case strings.HasPrefix(rule, "+"), strings.HasPrefix(rule, "-"):
+		p.commands = append(p.commands, rule)
+	case strings.HasPrefix(rule, "%RW~"):
+		p.keys = append(p.keys, "~"+strings.TrimPrefix(rule, "%RW~"))
+	case strings.HasPrefix(rule, "~"), strings.HasPrefix(rule, "%R~"), strings.HasPrefix(rule, "%W~"):
+		p.keys = append(p.keys, rule)
+	case strings.HasPrefix(rule, "&"):
+		p.channels = append(p.channels, rule)
+	default:
+		return false
+	}
+	return true
+}
+
+func (p *aclPermissionRules) aclMap() map[string]any {
+	return map[string]any{
+		"commands": strings.Join(p.commands, " "),
+		"keys":     strings.Join(p.keys, " "),
+		"channels": strings.Join(p.channels, " "),
+	}
+}
+
+// aclMap renders the parsed user in the shape of an ACL GETUSER reply.
+func (p *parsedAclRules) aclMap() map[string]any {
+	flags := []any{"off"}
+	if p.enabled {
+		flags = []any{"on"}
+	}
+	if p.nopass {
+		flags = append(flags, "nopass")
+	}
+	if p.allKeys {
+		flags = append(flags, "allkeys")
+	}
+	if p.allChannels {
+		flags = append(flags, "allchannels")
+	}
+
+	aclMap := p.permissions.aclMap()
+	aclMap["flags"] = flags
+	aclMap["passwords"] = toAny(p.passwords)
+	selectors := []any{}
+	for _, selector := range p.selectors {
+		selectors = append(selectors, selector.aclMap())
+	}
+	aclMap["selectors"] = selectors
+	return aclMap
+}
+
+// parseAclRuleString applies the rules of line in order. A leading
+// 'user <name>' as written in aclfiles is skipped.
+func parseAclRuleString(line string) (*parsedAclRules, error) {
+	rules := splitAclRules(strings.TrimSpace(line))
+	if len(rules) >= 2 && rules[0] == "user" {
+		rules = rules[2:]
+	}
+
+	p := &parsedAclRules{}
+	for _, rule := range rules {
+		switch strings.ToLower(rule) {
+		case "reset":
+			*p = parsedAclRules{}
+			continue
+		case "on":
+			p.enabled = true
+			continue
+		case "off":
+			p.enabled = false
+			continue
+		case "nopass":
+			p.nopass = true
+			p.passwords = nil
+			continue
+		case "resetpass":
+			p.nopass = false
+			p.passwords = nil
+			continue
+		case "allkeys":
+			p.allKeys = true
+			continue
+		case "resetkeys":
+			p.resetKeys = true
+			p.allKeys = false
+			p.permissions.keys = nil
+			continue
+		case "allchannels":
+			p.allChannels = true
+			continue
+		case "resetchannels":
+			p.resetChannels = true
+			p.allChannels = false
+			p.permissions.channels = nil
+			continue
+		case "clearselectors":
+			p.selectors = nil
+			continue
+		case "sanitize-payload", "skip-sanitize-payload":
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(rule, "#"), strings.HasPrefix(rule, ">"):
+			hash := strings.ToLower(rule[1:])
+			if strings.HasPrefix(rule, ">") {
+				hash = hashPassword(rule[1:])
+			} else if !isPasswordHash(hash) {
+				return nil, fmt.Errorf("rule %q is not a 64 character hex encoded SHA256 hash", rule)
+			}
+			p.nopass = false
+			if !slices.Contains(p.passwords, hash) {
+				p.passwords = append(p.passwords, hash)
+			}
+		case strings.HasPrefix(rule, "!"), strings.HasPrefix(rule, "<"):
+			hash := strings.ToLower(rule[1:])
+			if strings.HasPrefix(rule, "<") {
+				hash = hashPassword(rule[1:])
+			}
+			p.passwords = slices.DeleteFunc(p.passwords, func(h string) bool { return h == hash })
+		case strings.HasPrefix(rule, "(") && strings.HasSuffix(rule, ")"):
+			var selector aclPermissionRules
+			for _, selectorRule := range strings.Fields(rule[1 : len(rule)-1]) {
+				if !selector.add(selectorRule) {
+					return nil, fmt.Errorf("unsupported rule %q in selector %s", selectorRule, rule)
+				}
+			}
+			p.selectors = append(p.selectors, selector)
+		default:
+			if !p.permissions.add(rule) {
+				return nil, fmt.Errorf("unsupported ACL rule %q", rule)
+			}
+		}
+	}
+	return p, nil
+}
+
+// aclRulesObjectValue renders m as the object returned by parse_acl_rules.
+// Lists are never null so the result can be passed to acl_rules unchanged.
+func aclRulesObjectValue(ctx context.Context, m *RedisAclUserResourceModel, hashes []string, diags *diag.Diagnostics) types.Object {
+	attrTypes := aclRulesAttributeTypes()
+	selectorType := aclRulesSelectorType()
+
+	selectorValues := func(s *RedisAclSelectorModel) map[string]attr.Value {
+		lists := []types.List{s.Commands, s.ExcludedCommands, s.Categories, s.ExcludedCategories, s.Keys, s.ReadonlyKeys, s.WriteonlyKeys, s.Channels}
+		values := map[string]attr.Value{}
+		for i, name := range aclRulesListAttributes {
+			values[name], _ = convertToTypesList(ctx, listValues(lists[i]), diags)
+		}
+		return values
+	}
+
+	values := selectorValues(&RedisAclSelectorModel{
+		Commands:           m.Commands,
+		ExcludedCommands:   m.ExcludedCommands,
+		Categories:         m.Categories,
+		ExcludedCategories: m.ExcludedCategories,
+		Keys:               m.Keys,
+		ReadonlyKeys:       m.ReadonlyKeys,
+		WriteonlyKeys:      m.WriteonlyKeys,
+		Channels:           m.Channels,
+	})
+	values["enabled"] = m.Enabled
+	values["nopass"] = m.NoPass
+	values["allkeys"] = m.AllKeys
+	values["allchannels"] = m.AllChannels
+	values["resetkeys"] = m.ResetKeys
+	values["resetchannels"] = m.ResetChannels
+	values["password_hashes"], _ = convertToTypesList(ctx, hashes, diags)
+
+	selectors := []attr.Value{}
+	for _, selector := range m.Selectors {
+		object, objectDiags := types.ObjectValue(selectorType.AttrTypes, selectorValues(&selector))
+		diags.Append(objectDiags...)
+		selectors = append(selectors, object)
+	}
+	selectorList, listDiags := types.ListValue(selectorType, selectors)
+	diags.Append(listDiags...)
+	values["selectors"] = selectorList
+
+	object, objectDiags := types.ObjectValue(attrTypes, values)
+	diags.Append(objectDiags...)
+	return object
+}
+
+func listValues(list types.List) []string {
+	values := []string{}
+	for _, v := range toStringList(list) {
+		values = append(values, v.ValueString())
+	}
+	return values
+}
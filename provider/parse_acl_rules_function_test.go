@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runParseAclRulesFunction(t *testing.T, rules string) (types.Object, *function.FuncError) {
+	t.Helper()
+	resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(aclRulesAttributeTypes()))}
+
+	NewParseAclRulesFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(rules)}),
+	}, resp)
+
+	if resp.Error != nil {
+		return types.Object{}, resp.Error
+	}
+	result, ok := resp.Result.Value().(types.Object)
+	require.True(t, ok)
+	return result, nil
+}
+
+func TestParseAclRulesFunction_Metadata(t *testing.T) {
+	t.Run("sets function name", func(t *testing.T) {
+		resp := &function.MetadataResponse{}
+
+		NewParseAclRulesFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+		assert.Equal(t, "parse_acl_rules", resp.Name)
+	})
+}
+
+func TestParseAclRulesFunction_Run(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+
+	t.Run("parses permissions", func(t *testing.T) {
+		result, err := runParseAclRulesFunction(t, "reset on #"+hash+" ~app:* %R~logs:* &events +@read -@dangerous +get -keys (~other:* +set)")
+
+		require.Nil(t, err)
+		attrs := result.Attributes()
+		assert.Equal(t, types.BoolValue(true), attrs["enabled"])
+		assert.Equal(t, types.BoolValue(false), attrs["nopass"])
+		assert.Equal(t, stringListValue(hash), attrs["password_hashes"])
+		assert.Equal(t, stringListValue("app:*"), attrs["keys"])
+		assert.Equal(t, stringListValue("logs:*"), attrs["readonly_keys"])
+		assert.Equal(t, stringListValue("events"), attrs["channels"])
+		assert.Equal(t, stringListValue("read"), attrs["categories"])
+		assert.Equal(t, stringListValue("dangerous"), attrs["excluded_categories"])
+		assert.Equal(t, stringListValue("get"), attrs["commands"])
+		assert.Equal(t, stringListValue("keys"), attrs["excluded_commands"])
+
+		selectors := attrs["selectors"].(types.List).Elements()
+		require.Len(t, selectors, 1)
+		selector := selectors[0].(types.Object).Attributes()
+		assert.Equal(t, stringListValue("other:*"), selector["keys"])
+		assert.Equal(t, stringListValue("set"), selector["commands"])
+		assert.Equal(t, stringListValue(), selector["channels"])
+	})
+
+	t.Run("applies rules in order", func(t *testing.T) {
+		result, err := runParseAclRulesFunction(t, "user app on >secret ~old:* resetkeys ~new:* nopass allchannels off")
+
+		require.Nil(t, err)
+		attrs := result.Attributes()
+		assert.Equal(t, types.BoolValue(false), attrs["enabled"])
+		assert.Equal(t, types.BoolValue(true), attrs["nopass"])
+		assert.Equal(t, stringListValue(), attrs["password_hashes"])
+		assert.Equal(t, types.BoolValue(true), attrs["resetkeys"])
+		assert.Equal(t, stringListValue("new:*"), attrs["keys"])
+		assert.Equal(t, types.BoolValue(true), attrs["allchannels"])
+	})
+
+	t.Run("hashes plaintext passwords", func(t *testing.T) {
+		result, err := runParseAclRulesFunction(t, "on >secret >other <other")
+
+		require.Nil(t, err)
+		assert.Equal(t, stringListValue(hashPassword("secret")), result.Attributes()["password_hashes"])
+	})
+
+	t.Run("round trips through acl_rules", func(t *testing.T) {
+		rules := "reset on #" + hash + " resetkeys allkeys ~app:* &events +@read -@dangerous (~other:* +get)"
+		result, err := runParseAclRulesFunction(t, rules)
+		require.Nil(t, err)
+
+		rendered, err := runAclRulesFunction(t, result)
+
+		require.Nil(t, err)
+		assert.Equal(t, rules, rendered)
+	})
+
+	t.Run("rejects unsupported rules", func(t *testing.T) {
+		_, err := runParseAclRulesFunction(t, "on bogus")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `unsupported ACL rule "bogus"`)
+	})
+
+	t.Run("rejects invalid hashes", func(t *testing.T) {
+		_, err := runParseAclRulesFunction(t, "on #secret")
+
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// privatePasswordsKey holds keyed digests of the password hashes last applied
+// by the provider, so Read can spot passwords changed outside Terraform
+// without keeping the hashes themselves in state.
+const privatePasswordsKey = "password_hashes"
+
+type passwordDigests struct {
+	Salt    string   `json:"salt"`
+	Digests []string `json:"digests"`
+}
+
+func newPasswordDigests(hashes []string) (*passwordDigests, error) {
+	salt, err := newSalt()
+	if err != nil {
+		return nil, err
+	}
+	p := &passwordDigests{Salt: salt, Digests: []string{}}
+	for _, hash := range hashes {
+		p.Digests = append(p.Digests, p.digest(hash))
+	}
+	slices.Sort(p.Digests)
+	return p, nil
+}
+
+func (p *passwordDigests) digest(hash string) string {
+	return keyedDigest(p.Salt, hash)
+}
+
+func keyedDigest(salt string, hash string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(hash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSalt() (string, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(salt), nil
+}
+
+// matches reports whether hashes are exactly the recorded password hashes.
+func (p *passwordDigests) matches(hashes []string) bool {
+	digests := []string{}
+	for _, hash := range hashes {
+		digests = append(digests, p.digest(hash))
+	}
+	slices.Sort(digests)
+	return slices.Equal(slices.Compact(digests), slices.Compact(slices.Clone(p.Digests)))
+}
+
+func getPrivatePasswords(ctx context.Context, private privateState) (*passwordDigests, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, privatePasswordsKey)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+	var p passwordDigests
+	if err := json.Unmarshal(data, &p); err != nil {
+		diags.AddError("Failed to decode private state", err.Error())
+		return nil, diags
+	}
+	return &p, diags
+}
+
+// setPrivatePasswords records the applied password hashes, or clears the
+// record when hashes is nil.
+func setPrivatePasswords(ctx context.Context, private privateState, hashes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if hashes == nil {
+		return private.SetKey(ctx, privatePasswordsKey, nil)
+	}
+	p, err := newPasswordDigests(hashes)
+	if err != nil {
+		diags.AddError("Failed to record password hashes", err.Error())
+		return diags
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		diags.AddError("Failed to encode private state", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, privatePasswordsKey, data)
+}
+
+// contains reports whether hash is one of the recorded password hashes.
+func (p *passwordDigests) contains(hash string) bool {
+	return slices.Contains(p.Digests, p.digest(hash))
+}
+
+// privatePreviousPasswordsKey holds the previous password hashes kept during
+// a password_rotation grace window.
+const privatePreviousPasswordsKey = "previous_password_hashes"
+
+type previousPasswords struct {
+	passwordDigests
+	AppliesLeft *int64     `json:"applies_left,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+func newPreviousPasswords(hashes []string, rotation *RedisAclPasswordRotationModel, now time.Time) (*previousPasswords, error) {
+	digests, err := newPasswordDigests(hashes)
+	if err != nil {
+		return nil, err
+	}
+	p := &previousPasswords{passwordDigests: *digests}
+	if !rotation.KeepPreviousApplies.IsNull() {
+		applies := rotation.KeepPreviousApplies.ValueInt64()
+		p.AppliesLeft = &applies
+	}
+	if v := rotation.KeepPreviousFor.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		expiresAt := now.Add(d).UTC()
+		p.ExpiresAt = &expiresAt
+	}
+	return p, nil
+}
+
+// expired reports whether the grace window is over. With both limits set
+// the previous passwords are dropped as soon as either is reached.
+func (p *previousPasswords) expired(now time.Time) bool {
+	if p.AppliesLeft != nil && *p.AppliesLeft <= 0 {
+		return true
+	}
+	return p.ExpiresAt != nil && !now.Before(*p.ExpiresAt)
+}
+
+// needsApply reports whether the next plan must update the resource, either
+// to count down the remaining applies or to drop expired passwords.
+func (p *previousPasswords) needsApply(now time.Time) bool {
+	return p.AppliesLeft != nil || p.expired(now)
+}
+
+// rotatePasswordHashes returns the hashes to apply for newHash. The hashes the
+// provider applied last, except those already kept from an earlier rotation,
+// stay valid as previous passwords when rotation is configured.
+func rotatePasswordHashes(newHash string, serverHashes []string, applied *passwordDigests, previous *previousPasswords, rotation *RedisAclPasswordRotationModel) (hashes []string, kept []string) {
+	hashes = []string{newHash}
+	if rotation == nil || applied == nil {
+		return hashes, nil
+	}
+	for _, hash := range serverHashes {
+		if hash == newHash || !applied.contains(hash) {
+			continue
+		}
+		if previous != nil && previous.contains(hash) {
+			continue
+		}
+		kept = append(kept, hash)
+	}
+	return append(hashes, kept...), kept
+}
+
+// expirePreviousPasswords counts an apply against previous and drops its
+// hashes from serverHashes once the grace window is over. It returns the
+// hashes to apply and the record to keep, nil when the window is closed.
+func expirePreviousPasswords(serverHashes []string, previous *previousPasswords, now time.Time) ([]string, *previousPasswords) {
+	if previous == nil {
+		return serverHashes, nil
+	}
+	if previous.AppliesLeft != nil {
+		left := *previous.AppliesLeft - 1
+		previous.AppliesLeft = &left
+	}
+	if !previous.expired(now) {
+		return serverHashes, previous
+	}
+	hashes := slices.DeleteFunc(slices.Clone(serverHashes), previous.contains)
+	return hashes, nil
+}
+
+func getPrivatePreviousPasswords(ctx context.Context, private privateState) (*previousPasswords, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, privatePreviousPasswordsKey)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+	var p previousPasswords
+	if err := json.Unmarshal(data, &p); err != nil {
+		diags.AddError("Failed to decode private state", err.Error())
+		return nil, diags
+	}
+	return &p, diags
+}
+
+func setPrivatePreviousPasswords(ctx context.Context, private privateState, previous *previousPasswords) diag.Diagnostics {
+	if previous == nil {
+		return private.SetKey(ctx, privatePreviousPasswordsKey, nil)
+	}
+	data, err := json.Marshal(previous)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to encode private state", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, privatePreviousPasswordsKey, data)
+}
+
+// privatePasswordEntriesKey holds the version and keyed digest of the hash
+// applied for each password block, so unchanged entries keep their hash.
+const privatePasswordEntriesKey = "password_entries"
+
+type passwordEntries struct {
+	Salt    string                   `json:"salt"`
+	Entries map[string]passwordEntry `json:"entries"`
+}
+
+type passwordEntry struct {
+	Version string `json:"version"`
+	Digest  string `json:"digest"`
+}
+
+// owns reports whether hash was applied for any password block.
+func (p *passwordEntries) owns(hash string) bool {
+	digest := keyedDigest(p.Salt, hash)
+	for _, entry := range p.Entries {
+		if entry.Digest == digest {
+			return true
+		}
+	}
+	return false
+}
+
+// changed reports whether the hash applied for the password block name is no
+// longer among hashes.
+func (p *passwordEntries) changed(name string, hashes []string) bool {
+	entry, ok := p.Entries[name]
+	if !ok {
+		return false
+	}
+	return !slices.ContainsFunc(hashes, func(hash string) bool { return keyedDigest(p.Salt, hash) == entry.Digest })
+}
+
+// resolvePasswordEntryHashes returns the hash to apply for each password
+// block, in block order. A block whose version is unchanged keeps the hash
+// found on the server; new blocks and blocks with a new version are hashed
+// from their configured value.
+func resolvePasswordEntryHashes(plan []RedisAclPasswordModel, config []RedisAclPasswordModel, serverHashes []string, stored *passwordEntries) []string {
+	hashes := []string{}
+	for i, entry := range plan {
+		name := entry.Name.ValueString()
+		if stored != nil {
+			if prior, ok := stored.Entries[name]; ok && prior.Version == entry.Version.ValueString() {
+				idx := slices.IndexFunc(serverHashes, func(hash string) bool { return keyedDigest(stored.Salt, hash) == prior.Digest })
+				if idx >= 0 {
+					hashes = append(hashes, serverHashes[idx])
+					continue
+				}
+			}
+		}
+		if i < len(config) && !config[i].ValueWo.IsNull() && !config[i].ValueWo.IsUnknown() {
+			hashes = append(hashes, hashPassword(config[i].ValueWo.ValueString()))
+		}
+	}
+	return hashes
+}
+
+func newPasswordEntries(plan []RedisAclPasswordModel, hashes []string) (*passwordEntries, error) {
+	salt, err := newSalt()
+	if err != nil {
+		return nil, err
+	}
+	p := &passwordEntries{Salt: salt, Entries: map[string]passwordEntry{}}
+	for i, entry := range plan {
+		if i >= len(hashes) {
+			break
+		}
+		p.Entries[entry.Name.ValueString()] = passwordEntry{
+			Version: entry.Version.ValueString(),
+			Digest:  keyedDigest(salt, hashes[i]),
+		}
+	}
+	return p, nil
+}
+
+func getPrivatePasswordEntries(ctx context.Context, private privateState) (*passwordEntries, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, privatePasswordEntriesKey)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+	var p passwordEntries
+	if err := json.Unmarshal(data, &p); err != nil {
+		diags.AddError("Failed to decode private state", err.Error())
+		return nil, diags
+	}
+	return &p, diags
+}
+
+// setPrivatePasswordEntries records the hashes applied for the password
+// blocks of plan, or clears the record when there are none.
+func setPrivatePasswordEntries(ctx context.Context, private privateState, plan []RedisAclPasswordModel, hashes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(plan) == 0 {
+		return private.SetKey(ctx, privatePasswordEntriesKey, nil)
+	}
+	p, err := newPasswordEntries(plan, hashes)
+	if err != nil {
+		diags.AddError("Failed to record password hashes", err.Error())
+		return diags
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		diags.AddError("Failed to encode private state", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, privatePasswordEntriesKey, data)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordDigests(t *testing.T) {
+	hashA, hashB := hashPassword("a"), hashPassword("b")
+
+	t.Run("matches the recorded hashes in any order", func(t *testing.T) {
+		p, err := newPasswordDigests([]string{hashA, hashB})
+		require.NoError(t, err)
+
+		assert.True(t, p.matches([]string{hashB, hashA}))
+	})
+
+	t.Run("detects replaced and added hashes", func(t *testing.T) {
+		p, err := newPasswordDigests([]string{hashA})
+		require.NoError(t, err)
+
+		assert.False(t, p.matches([]string{hashB}))
+		assert.False(t, p.matches([]string{hashA, hashB}))
+		assert.False(t, p.matches([]string{}))
+	})
+
+	t.Run("does not store the hashes", func(t *testing.T) {
+		p, err := newPasswordDigests([]string{hashA})
+		require.NoError(t, err)
+
+		assert.NotContains(t, p.Digests, hashA)
+		assert.NotEmpty(t, p.Salt)
+	})
+}
+
+func TestPrivatePasswords(t *testing.T) {
+	ctx := context.Background()
+	hash := hashPassword("secret")
+
+	t.Run("round trips digests", func(t *testing.T) {
+		private := fakePrivateState{}
+		require.False(t, setPrivatePasswords(ctx, private, []string{hash}).HasError())
+
+		stored, diags := getPrivatePasswords(ctx, private)
+		require.False(t, diags.HasError())
+		require.NotNil(t, stored)
+		assert.True(t, stored.matches([]string{hash}))
+	})
+
+	t.Run("clears digests when nil", func(t *testing.T) {
+		private := fakePrivateState{}
+		require.False(t, setPrivatePasswords(ctx, private, []string{hash}).HasError())
+		require.False(t, setPrivatePasswords(ctx, private, nil).HasError())
+
+		stored, diags := getPrivatePasswords(ctx, private)
+		require.False(t, diags.HasError())
+		assert.Nil(t, stored)
+	})
+}
+
+func TestAppliedPasswordHashes(t *testing.T) {
+	assert.Equal(t, []string{"abc"}, appliedPasswordHashes(&RedisAclUserResourceModel{}, []string{"abc"}))
+	assert.Nil(t, appliedPasswordHashes(&RedisAclUserResourceModel{NoPass: types.BoolValue(true)}, []string{"abc"}))
+}
+
+func TestRotatePasswordHashes(t *testing.T) {
+	oldHash, newHash, foreignHash := hashPassword("old"), hashPassword("new"), hashPassword("foreign")
+	applied, err := newPasswordDigests([]string{oldHash})
+	require.NoError(t, err)
+	rotation := &RedisAclPasswordRotationModel{KeepPreviousApplies: types.Int64Value(1)}
+
+	t.Run("replaces hashes without rotation", func(t *testing.T) {
+		hashes, kept := rotatePasswordHashes(newHash, []string{oldHash}, applied, nil, nil)
+
+		assert.Equal(t, []string{newHash}, hashes)
+		assert.Empty(t, kept)
+	})
+
+	t.Run("keeps the applied hash", func(t *testing.T) {
+		hashes, kept := rotatePasswordHashes(newHash, []string{oldHash, foreignHash}, applied, nil, rotation)
+
+		assert.Equal(t, []string{newHash, oldHash}, hashes)
+		assert.Equal(t, []string{oldHash}, kept)
+	})
+
+	t.Run("drops hashes kept by an earlier rotation", func(t *testing.T) {
+		olderHash := hashPassword("older")
+		applied, err := newPasswordDigests([]string{oldHash, olderHash})
+		require.NoError(t, err)
+		previous, err := newPreviousPasswords([]string{olderHash}, rotation, time.Now())
+		require.NoError(t, err)
+
+		hashes, kept := rotatePasswordHashes(newHash, []string{oldHash, olderHash}, applied, previous, rotation)
+
+		assert.Equal(t, []string{newHash, oldHash}, hashes)
+		assert.Equal(t, []string{oldHash}, kept)
+	})
+
+	t.Run("keeps nothing without a record of applied hashes", func(t *testing.T) {
+		hashes, kept := rotatePasswordHashes(newHash, []string{oldHash}, nil, nil, rotation)
+
+		assert.Equal(t, []string{newHash}, hashes)
+		assert.Empty(t, kept)
+	})
+}
+
+func TestExpirePreviousPasswords(t *testing.T) {
+	oldHash, newHash := hashPassword("old"), hashPassword("new")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("passes hashes through without a record", func(t *testing.T) {
+		hashes, previous := expirePreviousPasswords([]string{newHash}, nil, now)
+
+		assert.Equal(t, []string{newHash}, hashes)
+		assert.Nil(t, previous)
+	})
+
+	t.Run("counts down applies", func(t *testing.T) {
+		previous, err := newPreviousPasswords([]string{oldHash}, &RedisAclPasswordRotationModel{KeepPreviousApplies: types.Int64Value(2)}, now)
+		require.NoError(t, err)
+
+		hashes, previous := expirePreviousPasswords([]string{newHash, oldHash}, previous, now)
+		require.NotNil(t, previous)
+		assert.Equal(t, []string{newHash, oldHash}, hashes)
+		assert.Equal(t, int64(1), *previous.AppliesLeft)
+
+		hashes, previous = expirePreviousPasswords(hashes, previous, now)
+		assert.Nil(t, previous)
+		assert.Equal(t, []string{newHash}, hashes)
+	})
+
+	t.Run("drops hashes after the time window", func(t *testing.T) {
+		previous, err := newPreviousPasswords([]string{oldHash}, &RedisAclPasswordRotationModel{KeepPreviousFor: types.StringValue("1h")}, now)
+		require.NoError(t, err)
+
+		_, kept := expirePreviousPasswords([]string{newHash, oldHash}, previous, now.Add(30*time.Minute))
+		require.NotNil(t, kept)
+		assert.False(t, kept.needsApply(now.Add(30*time.Minute)))
+
+		hashes, expired := expirePreviousPasswords([]string{newHash, oldHash}, previous, now.Add(time.Hour))
+		assert.Nil(t, expired)
+		assert.Equal(t, []string{newHash}, hashes)
+	})
+}
+
+func TestPrivatePreviousPasswords(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("round trips the grace window", func(t *testing.T) {
+		private := fakePrivateState{}
+		previous, err := newPreviousPasswords([]string{hashPassword("old")}, &RedisAclPasswordRotationModel{
+			KeepPreviousApplies: types.Int64Value(3),
+			KeepPreviousFor:     types.StringValue("24h"),
+		}, now)
+		require.NoError(t, err)
+		require.False(t, setPrivatePreviousPasswords(ctx, private, previous).HasError())
+
+		stored, diags := getPrivatePreviousPasswords(ctx, private)
+		require.False(t, diags.HasError())
+		require.NotNil(t, stored)
+		assert.True(t, stored.contains(hashPassword("old")))
+		assert.Equal(t, int64(3), *stored.AppliesLeft)
+		assert.Equal(t, now.Add(24*time.Hour), *stored.ExpiresAt)
+		assert.Equal(t, "2026-01-02T00:00:00Z", previousPasswordExpiresAt(stored).ValueString())
+	})
+
+	t.Run("clears the record when nil", func(t *testing.T) {
+		private := fakePrivateState{privatePreviousPasswordsKey: []byte(`{"salt":"a","digests":[]}`)}
+		require.False(t, setPrivatePreviousPasswords(ctx, private, nil).HasError())
+
+		stored, _ := getPrivatePreviousPasswords(ctx, private)
+		assert.Nil(t, stored)
+		assert.True(t, previousPasswordExpiresAt(stored).IsNull())
+	})
+}
+
+func TestResolvePasswordEntryHashes(t *testing.T) {
+	entry := func(name, value, version string) RedisAclPasswordModel {
+		return RedisAclPasswordModel{
+			Name:    types.StringValue(name),
+			ValueWo: types.StringValue(value),
+			Version: types.StringValue(version),
+		}
+	}
+	hashA, hashB := hashPassword("a"), hashPassword("b")
+
+	t.Run("hashes configured values without a record", func(t *testing.T) {
+		config := []RedisAclPasswordModel{entry("svc-a", "a", "1"), entry("svc-b", "b", "1")}
+		plan := []RedisAclPasswordModel{entry("svc-a", "", "1"), entry("svc-b", "", "1")}
+
+		assert.Equal(t, []string{hashA, hashB}, resolvePasswordEntryHashes(plan, config, nil, nil))
+	})
+
+	t.Run("rotates only entries with a new version", func(t *testing.T) {
+		stored, err := newPasswordEntries([]RedisAclPasswordModel{entry("svc-a", "", "1"), entry("svc-b", "", "1")}, []string{hashA, hashB})
+		require.NoError(t, err)
+		// Write-only values may differ on every run; only the version decides.
+		config := []RedisAclPasswordModel{entry("svc-a", "a2", "1"), entry("svc-b", "b2", "2")}
+
+		hashes := resolvePasswordEntryHashes(config, config, []string{hashA, hashB}, stored)
+
+		assert.Equal(t, []string{hashA, hashPassword("b2")}, hashes)
+		assert.True(t, stored.owns(hashB))
+		assert.False(t, stored.owns(hashPassword("b2")))
+	})
+
+	t.Run("rehashes entries whose hash is gone from the server", func(t *testing.T) {
+		stored, err := newPasswordEntries([]RedisAclPasswordModel{entry("svc-a", "", "1")}, []string{hashA})
+		require.NoError(t, err)
+		config := []RedisAclPasswordModel{entry("svc-a", "a", "1")}
+
+		assert.Equal(t, []string{hashA}, resolvePasswordEntryHashes(config, config, []string{}, stored))
+	})
+}
+
+func TestPrivatePasswordEntries(t *testing.T) {
+	ctx := context.Background()
+	plan := []RedisAclPasswordModel{{Name: types.StringValue("svc"), Version: types.StringValue("3")}}
+
+	t.Run("round trips entries", func(t *testing.T) {
+		private := fakePrivateState{}
+		require.False(t, setPrivatePasswordEntries(ctx, private, plan, []string{hashPassword("a")}).HasError())
+
+		stored, diags := getPrivatePasswordEntries(ctx, private)
+		require.False(t, diags.HasError())
+		require.NotNil(t, stored)
+		assert.Equal(t, "3", stored.Entries["svc"].Version)
+		assert.True(t, stored.owns(hashPassword("a")))
+	})
+
+	t.Run("detects removed block hashes", func(t *testing.T) {
+		stored, err := newPasswordEntries(plan, []string{hashPassword("a")})
+		require.NoError(t, err)
+
+		assert.False(t, stored.changed("svc", []string{hashPassword("b"), hashPassword("a")}))
+		assert.True(t, stored.changed("svc", []string{hashPassword("b")}))
+		assert.False(t, stored.changed("other", []string{}))
+	})
+
+	t.Run("clears entries without password blocks", func(t *testing.T) {
+		private := fakePrivateState{privatePasswordEntriesKey: []byte(`{"salt":"a","entries":{}}`)}
+		require.False(t, setPrivatePasswordEntries(ctx, private, nil, nil).HasError())
+
+		stored, _ := getPrivatePasswordEntries(ctx, private)
+		assert.Nil(t, stored)
+	})
+}
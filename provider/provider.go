@@ -2,20 +2,32 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
 )
 
 var _ provider.Provider = (*RedisProvider)(nil)
 var _ provider.ProviderWithEphemeralResources = (*RedisProvider)(nil)
+var _ provider.ProviderWithFunctions = (*RedisProvider)(nil)
 
-type RedisProvider struct{}
+type RedisProvider struct {
+	// client is the connection pool created by the last Configure call. It is
+	// closed when the provider is configured again.
+	client redis.UniversalClient
+}
 
 func New() func() provider.Provider {
 	return func() provider.Provider {
@@ -24,24 +36,174 @@ func New() func() provider.Provider {
 }
 
 type RedisProviderModel struct {
-	Address  types.String `tfsdk:"address"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	URL        types.String                `tfsdk:"url"`
+	Address    types.String                `tfsdk:"address"`
+	Network    types.String                `tfsdk:"network"`
+	SocketPath types.String                `tfsdk:"socket_path"`
+	Database   types.Int64                 `tfsdk:"database"`
+	Username   types.String                `tfsdk:"username"`
+	Password   types.String                `tfsdk:"password"`
+	TLS        *RedisProviderTLSModel      `tfsdk:"tls"`
+	Sentinel   *RedisProviderSentinelModel `tfsdk:"sentinel"`
+	Cluster    *RedisProviderClusterModel  `tfsdk:"cluster"`
+
+	PoolSize     types.Int64 `tfsdk:"pool_size"`
+	MinIdleConns types.Int64 `tfsdk:"min_idle_conns"`
+
+	DialTimeout     types.String `tfsdk:"dial_timeout"`
+	ReadTimeout     types.String `tfsdk:"read_timeout"`
+	WriteTimeout    types.String `tfsdk:"write_timeout"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	MinRetryBackoff types.String `tfsdk:"min_retry_backoff"`
+	MaxRetryBackoff types.String `tfsdk:"max_retry_backoff"`
+}
+
+type RedisProviderSentinelModel struct {
+	MasterName types.String `tfsdk:"master_name"`
+	Addresses  types.List   `tfsdk:"addresses"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+}
+
+type RedisProviderClusterModel struct {
+	Addresses types.List `tfsdk:"addresses"`
 }
 
 func (p *RedisProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Connection URL using the redis://, rediss:// or unix:// scheme, optionally with credentials and a database number. Can also be set with the REDIS_URL environment variable, which is ignored when a sentinel or cluster block is set.",
+			},
 			"address": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "Address of the Redis server. Can also be set with the REDIS_ADDRESS environment variable. Required unless url, a sentinel or a cluster block provides the addresses.",
+			},
+			"network": schema.StringAttribute{
+				Optional:    true,
+				Description: "Network type used to reach Redis, either tcp or unix. Defaults to tcp.",
+			},
+			"socket_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the unix domain socket Redis listens on. Required when network is unix.",
+			},
+			"database": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Index of the logical database to select. Defaults to 0.",
 			},
 			"username": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Username used to authenticate. Can also be set with the REDIS_USERNAME environment variable.",
 			},
 			"password": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used to authenticate. Can also be set with the REDIS_PASSWORD environment variable.",
+			},
+			"pool_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of connections in the pool shared by all resources. Defaults to 10 per CPU.",
+			},
+			"min_idle_conns": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum number of idle connections kept open in the pool.",
+			},
+			"dial_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for establishing new connections, as a duration string (e.g. 5s). Defaults to 5s.",
+			},
+			"read_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for socket reads, as a duration string. Defaults to 3s.",
+			},
+			"write_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for socket writes, as a duration string. Defaults to read_timeout.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for a failed command. Set to 0 to disable retries. Defaults to 3.",
+			},
+			"min_retry_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum backoff between retries, as a duration string. Defaults to 8ms.",
+			},
+			"max_retry_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum backoff between retries, as a duration string. Defaults to 512ms.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tls": schema.SingleNestedBlock{
+				Description: "TLS settings for the connection to Redis.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to connect using TLS. Defaults to true when the block is present.",
+					},
+					"ca_cert": schema.StringAttribute{
+						Optional:    true,
+						Description: "PEM-encoded CA certificate, or a path to one, used to verify the server.",
+					},
+					"client_cert": schema.StringAttribute{
+						Optional:    true,
+						Description: "PEM-encoded client certificate, or a path to one, for mutual TLS.",
+					},
+					"client_key": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "PEM-encoded client private key, or a path to one, for mutual TLS.",
+					},
+					"server_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Server name used to verify the certificate presented by Redis.",
+					},
+					"insecure_skip_verify": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Skip verification of the server certificate.",
+					},
+					"min_version": schema.StringAttribute{
+						Optional:    true,
+						Description: "Minimum TLS version (1.0, 1.1, 1.2 or 1.3). Defaults to 1.2.",
+					},
+				},
+			},
+			"sentinel": schema.SingleNestedBlock{
+				Description: "Connect to the master discovered through Redis Sentinel instead of a fixed address.",
+				Attributes: map[string]schema.Attribute{
+					"master_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Name of the master monitored by Sentinel.",
+					},
+					"addresses": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Addresses of the Sentinel instances.",
+					},
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Username used to authenticate against Sentinel.",
+					},
+					"password": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Password used to authenticate against Sentinel.",
+					},
+				},
+			},
+			"cluster": schema.SingleNestedBlock{
+				Description: "Connect to a Redis Cluster. ACL changes are applied to every primary and replica, since ACL rules are not propagated between cluster nodes.",
+				Attributes: map[string]schema.Attribute{
+					"addresses": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Seed node addresses used to discover the cluster. Defaults to address.",
+					},
+				},
 			},
 		},
 	}
@@ -52,23 +214,192 @@ func (p *RedisProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	providerData := &RedisProviderModel{
-		Address:  data.Address,
-		Username: data.Username,
-		Password: data.Password,
+
+	// Connection attributes fed from resources that are not created yet are
+	// unknown during plan. The client is then left unconfigured; resources
+	// keep their prior state and skip server-side checks until the values
+	// are known, while data sources and ephemeral resources report an error.
+	if hasUnknownConnectionAttributes(&data) {
+		return
+	}
+	applyEnvironmentDefaults(&data)
+
+	resp.Diagnostics.Append(validateProviderModel(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	providerData, err := newRedisProviderData(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Redis client", err.Error())
+		return
+	}
+
+	if err := providerData.Client.Ping(ctx).Err(); err != nil {
+		_ = providerData.Client.Close()
+		resp.Diagnostics.AddError("Unable to connect to Redis", err.Error())
+		return
+	}
+
+	if p.client != nil {
+		_ = p.client.Close()
+	}
+	p.client = providerData.Client
+
 	resp.ResourceData = providerData
 	resp.DataSourceData = providerData
 	resp.EphemeralResourceData = providerData
 }
 
+// hasUnknownConnectionAttributes reports whether any attribute needed to
+// build the client is not known yet.
+func hasUnknownConnectionAttributes(data *RedisProviderModel) bool {
+	values := []attr.Value{
+		data.URL, data.Address, data.Network, data.SocketPath, data.Database, data.Username, data.Password,
+		data.PoolSize, data.MinIdleConns, data.DialTimeout, data.ReadTimeout, data.WriteTimeout,
+		data.MaxRetries, data.MinRetryBackoff, data.MaxRetryBackoff,
+	}
+	if data.TLS != nil {
+		values = append(values, data.TLS.Enabled, data.TLS.CACert, data.TLS.ClientCert, data.TLS.ClientKey,
+			data.TLS.ServerName, data.TLS.InsecureSkipVerify, data.TLS.MinVersion)
+	}
+	if data.Sentinel != nil {
+		values = append(values, data.Sentinel.MasterName, data.Sentinel.Addresses, data.Sentinel.Username, data.Sentinel.Password)
+	}
+	if data.Cluster != nil {
+		values = append(values, data.Cluster.Addresses)
+	}
+	for _, v := range values {
+		if v.IsUnknown() {
+			return true
+		}
+		if list, ok := v.(types.List); ok {
+			for _, element := range list.Elements() {
+				if element.IsUnknown() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// applyEnvironmentDefaults fills connection attributes that are not set in the
+// configuration from REDIS_URL, REDIS_ADDRESS, REDIS_USERNAME and
+// REDIS_PASSWORD. When a url is set, from the configuration or REDIS_URL, it
+// fully describes the connection, so the other variables are ignored and only
+// attributes set in the configuration override its parts. REDIS_URL is not
+// read when a sentinel or cluster block is set, since a url cannot describe
+// those deployments.
+func applyEnvironmentDefaults(data *RedisProviderModel) {
+	if data.URL.IsNull() && data.Sentinel == nil && data.Cluster == nil {
+		if v, ok := os.LookupEnv("REDIS_URL"); ok && v != "" {
+			data.URL = types.StringValue(v)
+		}
+	}
+	if !data.URL.IsNull() {
+		return
+	}
+
+	envDefaults := []struct {
+		value *types.String
+		env   string
+	}{
+		{&data.Address, "REDIS_ADDRESS"},
+		{&data.Username, "REDIS_USERNAME"},
+		{&data.Password, "REDIS_PASSWORD"},
+	}
+	for _, d := range envDefaults {
+		if !d.value.IsNull() {
+			continue
+		}
+		if v, ok := os.LookupEnv(d.env); ok && v != "" {
+			*d.value = types.StringValue(v)
+		}
+	}
+}
+
+func validateProviderModel(data *RedisProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, err := buildTLSConfig(data.TLS); err != nil {
+		diags.AddAttributeError(path.Root("tls"), "Invalid TLS configuration", err.Error())
+	}
+
+	if !data.URL.IsNull() {
+		if _, err := redis.ParseURL(data.URL.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("url"), "Invalid Redis URL", err.Error())
+		}
+		if data.Sentinel != nil || data.Cluster != nil {
+			diags.AddAttributeError(path.Root("url"), "Conflicting connection modes", "url cannot be combined with the sentinel or cluster blocks")
+		}
+	}
+
+	if data.Sentinel != nil {
+		if data.Sentinel.MasterName.ValueString() == "" {
+			diags.AddAttributeError(path.Root("sentinel").AtName("master_name"), "Invalid Sentinel configuration", "master_name is required when the sentinel block is set")
+		}
+		if len(toStringList(data.Sentinel.Addresses)) == 0 {
+			diags.AddAttributeError(path.Root("sentinel").AtName("addresses"), "Invalid Sentinel configuration", "at least one sentinel address is required")
+		}
+		if data.Cluster != nil {
+			diags.AddAttributeError(path.Root("cluster"), "Conflicting connection modes", "sentinel and cluster cannot be configured together")
+		}
+	} else if data.Cluster != nil {
+		if data.Address.IsNull() && len(toStringList(data.Cluster.Addresses)) == 0 {
+			diags.AddAttributeError(path.Root("cluster").AtName("addresses"), "Missing cluster address", "address or cluster addresses must be set in cluster mode")
+		}
+	} else if data.Network.ValueString() == "unix" {
+		if data.SocketPath.ValueString() == "" {
+			diags.AddAttributeError(path.Root("socket_path"), "Missing socket path", "socket_path must be set when network is unix")
+		}
+	} else if data.Address.IsNull() && data.URL.IsNull() {
+		diags.AddAttributeError(path.Root("address"), "Missing Redis address", "address or url must be set, either in the configuration or through the REDIS_ADDRESS or REDIS_URL environment variables")
+	}
+
+	switch data.Network.ValueString() {
+	case "", "tcp", "unix":
+	default:
+		diags.AddAttributeError(path.Root("network"), "Invalid network", fmt.Sprintf("network must be tcp or unix, got %q", data.Network.ValueString()))
+	}
+	if !data.SocketPath.IsNull() && data.Network.ValueString() != "unix" {
+		diags.AddAttributeError(path.Root("socket_path"), "Invalid socket path", "socket_path can only be set when network is unix")
+	}
+	if (data.Network.ValueString() == "unix" || !data.SocketPath.IsNull()) && (data.Sentinel != nil || data.Cluster != nil) {
+		diags.AddAttributeError(path.Root("network"), "Conflicting connection modes", "unix sockets cannot be combined with the sentinel or cluster blocks")
+	}
+
+	if data.Database.ValueInt64() < 0 {
+		diags.AddAttributeError(path.Root("database"), "Invalid database", "database must not be negative")
+	}
+	if data.Database.ValueInt64() > 0 && data.Cluster != nil {
+		diags.AddAttributeError(path.Root("database"), "Invalid database", "Redis Cluster only supports database 0")
+	}
+
+	if _, err := connectionSettingsFromModel(data); err != nil {
+		diags.AddError("Invalid timeout or retry configuration", err.Error())
+	}
+
+	if data.PoolSize.ValueInt64() < 0 {
+		diags.AddAttributeError(path.Root("pool_size"), "Invalid pool size", "pool_size must not be negative")
+	}
+	if data.MinIdleConns.ValueInt64() < 0 {
+		diags.AddAttributeError(path.Root("min_idle_conns"), "Invalid pool size", "min_idle_conns must not be negative")
+	}
+
+	return diags
+}
+
 func (p *RedisProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "redis"
 }
 
 func (p *RedisProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewRedisAclUserDataSource,
+		NewRedisAclUsersDataSource,
+		NewRedisAclCategoriesDataSource,
+	}
 }
 
 func (p *RedisProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -78,5 +409,18 @@ func (p *RedisProvider) Resources(ctx context.Context) []func() resource.Resourc
 }
 
 func (p *RedisProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewRedisPasswordEphemeralResource,
+		NewRedisTemporaryAclUserEphemeralResource,
+		NewRedisConnectionInfoEphemeralResource,
+	}
+}
+
+func (p *RedisProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewAclRulesFunction,
+		NewParseAclRulesFunction,
+		NewKeySlotFunction,
+		NewSameSlotFunction,
+	}
 }
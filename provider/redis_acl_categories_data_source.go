@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ datasource.DataSource = &RedisAclCategoriesDataSource{}
+
+func NewRedisAclCategoriesDataSource() datasource.DataSource {
+	return &RedisAclCategoriesDataSource{}
+}
+
+type RedisAclCategoriesDataSource struct {
+	providerData *RedisProviderData
+}
+
+type RedisAclCategoriesDataSourceModel struct {
+	Categories types.List `tfsdk:"categories"`
+	Commands   types.Map  `tfsdk:"commands"`
+}
+
+func (d *RedisAclCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*RedisProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *RedisProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.providerData = providerData
+}
+
+func (d *RedisAclCategoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_categories"
+}
+
+func (d *RedisAclCategoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the ACL categories known to the Redis server and the commands in each.",
+		Attributes: map[string]schema.Attribute{
+			"categories": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted names of all ACL categories (without @ prefix).",
+			},
+			"commands": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Sorted commands of each ACL category, keyed by category name.",
+			},
+		},
+	}
+}
+
+func (d *RedisAclCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RedisAclCategoriesDataSourceModel
+
+	client, err := d.providerData.redisClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read ACL categories", err.Error())
+		return
+	}
+
+	categoryCommands, err := aclCategoryCommands(ctx, client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read ACL categories", err.Error())
+		return
+	}
+
+	categories := make([]string, 0, len(categoryCommands))
+	for category := range categoryCommands {
+		categories = append(categories, category)
+	}
+	slices.Sort(categories)
+
+	data.Categories, _ = convertToTypesList(ctx, categories, &resp.Diagnostics)
+	commands, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, categoryCommands)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Commands = commands
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// aclCategories returns the names reported by ACL CAT.
+func aclCategories(ctx context.Context, client redis.UniversalClient) ([]string, error) {
+	return client.Do(ctx, "ACL", "CAT").StringSlice()
+}
+
+// aclCategoryCommands returns the sorted commands of every ACL category,
+// keyed by category name.
+func aclCategoryCommands(ctx context.Context, client redis.UniversalClient) (map[string][]string, error) {
+	categories, err := aclCategories(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCommands := make(map[string][]string, len(categories))
+	for _, category := range categories {
+		commands, err := client.Do(ctx, "ACL", "CAT", category).StringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list commands of category %q: %w", category, err)
+		}
+		slices.Sort(commands)
+		categoryCommands[category] = commands
+	}
+	return categoryCommands, nil
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedisAclCategoriesDataSource(t *testing.T) {
+	t.Run("creates new data source instance", func(t *testing.T) {
+		dataSource := NewRedisAclCategoriesDataSource()
+
+		assert.NotNil(t, dataSource)
+		assert.IsType(t, &RedisAclCategoriesDataSource{}, dataSource)
+	})
+}
+
+func TestRedisAclCategoriesDataSource_Metadata(t *testing.T) {
+	t.Run("sets correct type name", func(t *testing.T) {
+		d := &RedisAclCategoriesDataSource{}
+		resp := &datasource.MetadataResponse{}
+
+		d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "redis"}, resp)
+
+		assert.Equal(t, "redis_acl_categories", resp.TypeName)
+	})
+}
+
+func TestRedisAclCategoriesDataSource_Schema(t *testing.T) {
+	t.Run("exposes categories and commands", func(t *testing.T) {
+		d := &RedisAclCategoriesDataSource{}
+		resp := &datasource.SchemaResponse{}
+
+		d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+		require.Contains(t, resp.Schema.Attributes, "categories")
+		require.Contains(t, resp.Schema.Attributes, "commands")
+		assert.True(t, resp.Schema.Attributes["commands"].IsComputed())
+	})
+}
+
+func TestAclCategoryCommands_Integration(t *testing.T) {
+	if os.Getenv("INTEGRATION") == "" {
+		t.Skip("set INTEGRATION=1 to run integration tests")
+	}
+	t.Run("lists categories with their commands", func(t *testing.T) {
+		providerData, err := newRedisProviderData(&RedisProviderModel{
+			Address:  types.StringValue("localhost:6379"),
+			Username: types.StringValue("testuser"),
+			Password: types.StringValue("supersecretpassword"),
+		})
+		require.NoError(t, err)
+		defer providerData.Client.Close()
+
+		categoryCommands, err := aclCategoryCommands(context.Background(), providerData.Client)
+
+		require.NoError(t, err)
+		assert.Contains(t, categoryCommands, "read")
+		assert.Contains(t, categoryCommands["read"], "get")
+		assert.Contains(t, categoryCommands["dangerous"], "flushall")
+	})
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ datasource.DataSource = &RedisAclUserDataSource{}
+
+func NewRedisAclUserDataSource() datasource.DataSource {
+	return &RedisAclUserDataSource{}
+}
+
+type RedisAclUserDataSource struct {
+	providerData *RedisProviderData
+}
+
+type RedisAclUserDataSourceModel struct {
+	Name               types.String            `tfsdk:"name"`
+	Enabled            types.Bool              `tfsdk:"enabled"`
+	Commands           types.List              `tfsdk:"commands"`
+	ExcludedCommands   types.List              `tfsdk:"excluded_commands"`
+	Categories         types.List              `tfsdk:"categories"`
+	ExcludedCategories types.List              `tfsdk:"excluded_categories"`
+	Keys               types.List              `tfsdk:"keys"`
+	ReadonlyKeys       types.List              `tfsdk:"readonly_keys"`
+	WriteonlyKeys      types.List              `tfsdk:"writeonly_keys"`
+	Channels           types.List              `tfsdk:"channels"`
+	Selectors          []RedisAclSelectorModel `tfsdk:"selectors"`
+	PasswordHashCount  types.Int64             `tfsdk:"password_hash_count"`
+}
+
+func (d *RedisAclUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*RedisProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *RedisProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.providerData = providerData
+}
+
+func (d *RedisAclUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_user"
+}
+
+func (d *RedisAclUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := aclUserComputedAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Name of the ACL user.",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Redis ACL user.",
+		Attributes:  attributes,
+	}
+}
+
+// aclUserComputedAttributes describes everything read from ACL GETUSER. It is
+// shared by the redis_acl_user and redis_acl_users data sources.
+func aclUserComputedAttributes() map[string]schema.Attribute {
+	listAttribute := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: description,
+		}
+	}
+	return map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the ACL user is enabled.",
+		},
+		"commands":            listAttribute("ACL commands allowed for the user."),
+		"excluded_commands":   listAttribute("ACL commands denied for the user."),
+		"categories":          listAttribute("ACL categories allowed for the user."),
+		"excluded_categories": listAttribute("ACL categories denied for the user."),
+		"keys":                listAttribute("Key patterns the user can access (without ~ prefix)."),
+		"readonly_keys":       listAttribute("Key patterns the user can only read (without %R~ prefix)."),
+		"writeonly_keys":      listAttribute("Key patterns the user can only write (without %W~ prefix)."),
+		"channels":            listAttribute("Pub/Sub channel patterns the user can access (without & prefix)."),
+		"password_hash_count": schema.Int64Attribute{Computed: true, Description: "Number of password hashes set for the user."},
+		"selectors": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Redis 7 selectors defined for the user, each granting an additional set of permissions.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"commands":            listAttribute("ACL commands allowed by the selector."),
+					"excluded_commands":   listAttribute("ACL commands denied by the selector."),
+					"categories":          listAttribute("ACL categories allowed by the selector."),
+					"excluded_categories": listAttribute("ACL categories denied by the selector."),
+					"keys":                listAttribute("Key patterns the selector can access."),
+					"readonly_keys":       listAttribute("Key patterns the selector can only read."),
+					"writeonly_keys":      listAttribute("Key patterns the selector can only write."),
+					"channels":            listAttribute("Pub/Sub channel patterns the selector can access."),
+				},
+			},
+		},
+	}
+}
+
+func (d *RedisAclUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RedisAclUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.providerData.redisClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read ACL user", err.Error())
+		return
+	}
+
+	aclData, err := aclGetUser(ctx, client, data.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			resp.Diagnostics.AddError("ACL user not found", fmt.Sprintf("ACL user '%s' does not exist", data.Name.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read ACL user", err.Error())
+		return
+	}
+
+	loadAclMapIntoDataSourceModel(ctx, parseAclDataToMap(aclData), &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func loadAclMapIntoDataSourceModel(ctx context.Context, aclMap map[string]any, data *RedisAclUserDataSourceModel, diags *diag.Diagnostics) {
+	data.Enabled = types.BoolValue(parseEnabledFromFlags(aclMap))
+	data.PasswordHashCount = types.Int64Value(int64(len(parsePasswordHashesFromAclMap(aclMap))))
+
+	selector := loadAclMapIntoSelectorModel(ctx, aclMap, diags)
+	data.Commands = selector.Commands
+	data.ExcludedCommands = selector.ExcludedCommands
+	data.Categories = selector.Categories
+	data.ExcludedCategories = selector.ExcludedCategories
+	data.Keys = selector.Keys
+	data.ReadonlyKeys = selector.ReadonlyKeys
+	data.WriteonlyKeys = selector.WriteonlyKeys
+	data.Channels = selector.Channels
+
+	data.Selectors = []RedisAclSelectorModel{}
+	for _, selectorMap := range parseSelectorsFromAclMap(aclMap) {
+		data.Selectors = append(data.Selectors, loadAclMapIntoSelectorModel(ctx, selectorMap, diags))
+	}
+}
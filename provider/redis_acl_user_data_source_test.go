@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedisAclUserDataSource(t *testing.T) {
+	t.Run("creates new data source instance", func(t *testing.T) {
+		dataSource := NewRedisAclUserDataSource()
+
+		assert.NotNil(t, dataSource)
+		assert.IsType(t, &RedisAclUserDataSource{}, dataSource)
+	})
+}
+
+func TestRedisAclUserDataSource_Metadata(t *testing.T) {
+	t.Run("sets correct type name", func(t *testing.T) {
+		d := &RedisAclUserDataSource{}
+		resp := &datasource.MetadataResponse{}
+
+		d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "redis"}, resp)
+
+		assert.Equal(t, "redis_acl_user", resp.TypeName)
+	})
+}
+
+func TestRedisAclUserDataSource_Configure(t *testing.T) {
+	t.Run("handles nil provider data", func(t *testing.T) {
+		d := &RedisAclUserDataSource{}
+		resp := &datasource.ConfigureResponse{}
+
+		d.Configure(context.Background(), datasource.ConfigureRequest{}, resp)
+
+		assert.Nil(t, d.providerData)
+		assert.False(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("sets provider data", func(t *testing.T) {
+		d := &RedisAclUserDataSource{}
+		providerData := &RedisProviderData{}
+		resp := &datasource.ConfigureResponse{}
+
+		d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: providerData}, resp)
+
+		assert.Same(t, providerData, d.providerData)
+	})
+
+	t.Run("rejects unexpected provider data", func(t *testing.T) {
+		d := &RedisAclUserDataSource{}
+		resp := &datasource.ConfigureResponse{}
+
+		d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestRedisAclUserDataSource_Schema(t *testing.T) {
+	t.Run("has all attributes", func(t *testing.T) {
+		d := &RedisAclUserDataSource{}
+		resp := &datasource.SchemaResponse{}
+
+		d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+		for _, name := range []string{"name", "enabled", "commands", "excluded_commands", "categories", "keys", "readonly_keys", "writeonly_keys", "channels", "selectors", "password_hash_count"} {
+			assert.Contains(t, resp.Schema.Attributes, name)
+		}
+		assert.True(t, resp.Schema.Attributes["name"].IsRequired())
+		assert.True(t, resp.Schema.Attributes["selectors"].IsComputed())
+	})
+}
+
+func TestLoadAclMapIntoDataSourceModel(t *testing.T) {
+	t.Run("loads all ACL data", func(t *testing.T) {
+		ctx := context.Background()
+		diags := &diag.Diagnostics{}
+		aclMap := map[string]any{
+			"flags":     []any{"on"},
+			"passwords": []any{"hash1", "hash2"},
+			"commands":  "-@all +@read +get -keys",
+			"keys":      "~app:* %R~readonly:*",
+			"channels":  "&notifications:*",
+			"selectors": []any{
+				map[any]any{
+					"commands": "-@all +set",
+					"keys":     "%W~writeonly:*",
+					"channels": "",
+				},
+			},
+		}
+		data := &RedisAclUserDataSourceModel{Name: types.StringValue("testuser")}
+
+		loadAclMapIntoDataSourceModel(ctx, aclMap, data, diags)
+
+		require.False(t, diags.HasError())
+		assert.True(t, data.Enabled.ValueBool())
+		assert.Equal(t, int64(2), data.PasswordHashCount.ValueInt64())
+		assertStringList(t, []string{"read"}, data.Categories)
+		assertStringList(t, []string{"get"}, data.Commands)
+		assertStringList(t, []string{"keys"}, data.ExcludedCommands)
+		assertStringList(t, []string{"app:*"}, data.Keys)
+		assertStringList(t, []string{"readonly:*"}, data.ReadonlyKeys)
+		assertStringList(t, []string{}, data.WriteonlyKeys)
+		assertStringList(t, []string{"notifications:*"}, data.Channels)
+		require.Len(t, data.Selectors, 1)
+		assertStringList(t, []string{"set"}, data.Selectors[0].Commands)
+		assertStringList(t, []string{"writeonly:*"}, data.Selectors[0].WriteonlyKeys)
+		assertStringList(t, []string{}, data.Selectors[0].Channels)
+	})
+
+	t.Run("handles empty ACL map", func(t *testing.T) {
+		ctx := context.Background()
+		diags := &diag.Diagnostics{}
+		data := &RedisAclUserDataSourceModel{Name: types.StringValue("testuser")}
+
+		loadAclMapIntoDataSourceModel(ctx, map[string]any{}, data, diags)
+
+		assert.False(t, diags.HasError())
+		assert.False(t, data.Enabled.ValueBool())
+		assert.Equal(t, int64(0), data.PasswordHashCount.ValueInt64())
+		assert.Empty(t, data.Selectors)
+		assert.False(t, data.Commands.IsNull())
+	})
+}
+
+func assertStringList(t *testing.T, expected []string, list types.List) {
+	t.Helper()
+	var actual []string
+	require.False(t, list.ElementsAs(context.Background(), &actual, false).HasError())
+	if len(expected) == 0 {
+		assert.Empty(t, actual)
+		return
+	}
+	assert.Equal(t, expected, actual)
+}
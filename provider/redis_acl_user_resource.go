@@ -3,8 +3,14 @@ package provider
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"maps"
+	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,35 +24,84 @@ import (
 )
 
 var _ resource.Resource = &RedisAclUserResource{}
+var _ resource.ResourceWithValidateConfig = &RedisAclUserResource{}
+var _ resource.ResourceWithModifyPlan = &RedisAclUserResource{}
 
 func NewRedisAclUserResource() resource.Resource {
 	return &RedisAclUserResource{}
 }
 
 type RedisAclUserResource struct {
-	providerData *RedisProviderModel
+	providerData *RedisProviderData
 }
 
 type RedisAclUserResourceModel struct {
-	Name              types.String `tfsdk:"name"`
-	Enabled           types.Bool   `tfsdk:"enabled"`
-	PasswordWo        types.String `tfsdk:"password_wo"`
-	PasswordWoVersion types.String `tfsdk:"password_wo_version"`
-	Commands          types.List   `tfsdk:"commands"`
-	ExcludedCommands  types.List   `tfsdk:"excluded_commands"`
-	Categories        types.List   `tfsdk:"categories"`
-	Keys              types.List   `tfsdk:"keys"`
-	ReadonlyKeys      types.List   `tfsdk:"readonly_keys"`
-	WriteonlyKeys     types.List   `tfsdk:"writeonly_keys"`
-	Channels          types.List   `tfsdk:"channels"`
-	AclSave           types.Bool   `tfsdk:"acl_save"`
+	Name                      types.String                   `tfsdk:"name"`
+	Enabled                   types.Bool                     `tfsdk:"enabled"`
+	PasswordWo                types.String                   `tfsdk:"password_wo"`
+	PasswordHashWo            types.String                   `tfsdk:"password_hash_wo"`
+	PasswordWoVersion         types.String                   `tfsdk:"password_wo_version"`
+	Commands                  types.List                     `tfsdk:"commands"`
+	ExcludedCommands          types.List                     `tfsdk:"excluded_commands"`
+	Categories                types.List                     `tfsdk:"categories"`
+	ExcludedCategories        types.List                     `tfsdk:"excluded_categories"`
+	Keys                      types.List                     `tfsdk:"keys"`
+	ReadonlyKeys              types.List                     `tfsdk:"readonly_keys"`
+	WriteonlyKeys             types.List                     `tfsdk:"writeonly_keys"`
+	Channels                  types.List                     `tfsdk:"channels"`
+	AllKeys                   types.Bool                     `tfsdk:"allkeys"`
+	AllChannels               types.Bool                     `tfsdk:"allchannels"`
+	ResetKeys                 types.Bool                     `tfsdk:"resetkeys"`
+	ResetChannels             types.Bool                     `tfsdk:"resetchannels"`
+	NoPass                    types.Bool                     `tfsdk:"nopass"`
+	Rules                     types.List                     `tfsdk:"rules"`
+	Selectors                 []RedisAclSelectorModel        `tfsdk:"selector"`
+	Asserts                   []RedisAclAssertModel          `tfsdk:"assert"`
+	Passwords                 []RedisAclPasswordModel        `tfsdk:"password"`
+	PasswordRotation          *RedisAclPasswordRotationModel `tfsdk:"password_rotation"`
+	PreviousPasswordExpiresAt types.String                   `tfsdk:"previous_password_expires_at"`
+	AclSave                   types.Bool                     `tfsdk:"acl_save"`
+	Timeouts                  *TimeoutsModel                 `tfsdk:"timeouts"`
+}
+
+type RedisAclSelectorModel struct {
+	Commands           types.List `tfsdk:"commands"`
+	ExcludedCommands   types.List `tfsdk:"excluded_commands"`
+	Categories         types.List `tfsdk:"categories"`
+	ExcludedCategories types.List `tfsdk:"excluded_categories"`
+	Keys               types.List `tfsdk:"keys"`
+	ReadonlyKeys       types.List `tfsdk:"readonly_keys"`
+	WriteonlyKeys      types.List `tfsdk:"writeonly_keys"`
+	Channels           types.List `tfsdk:"channels"`
+}
+
+type RedisAclAssertModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Allowed types.Bool   `tfsdk:"allowed"`
+}
+
+type RedisAclPasswordModel struct {
+	Name    types.String `tfsdk:"name"`
+	ValueWo types.String `tfsdk:"value_wo"`
+	Version types.String `tfsdk:"version"`
+}
+
+type RedisAclPasswordRotationModel struct {
+	KeepPreviousApplies types.Int64  `tfsdk:"keep_previous_applies"`
+	KeepPreviousFor     types.String `tfsdk:"keep_previous_for"`
 }
 
 func (r *RedisAclUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	r.providerData = req.ProviderData.(*RedisProviderModel)
+	providerData, ok := req.ProviderData.(*RedisProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *RedisProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
 }
 
 func (r *RedisAclUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,13 +122,19 @@ func (r *RedisAclUserResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:    true,
 			},
 			"password_wo": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only password for the ACL user. Password is hashed with SHA256 before being stored in Redis. Required unless nopass is true.",
+			},
+			"password_hash_wo": schema.StringAttribute{
+				Optional:    true,
 				Sensitive:   true,
 				WriteOnly:   true,
-				Description: "Write-only password for the ACL user. Password is hashed with SHA256 before being stored in Redis.",
+				Description: "Write-only SHA256 hash of the password as 64 hex characters, passed to Redis as is. Conflicts with password_wo.",
 			},
 			"password_wo_version": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Description: "Version string for password. Changing this value forces a password update even if password_wo hasn't changed in the configuration. Use this to rotate passwords.",
 			},
 			"commands": schema.ListAttribute{
@@ -103,6 +164,15 @@ func (r *RedisAclUserResource) Schema(ctx context.Context, req resource.SchemaRe
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"excluded_categories": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "ACL categories to exclude for the user (e.g., 'dangerous'), rendered as -@ rules after the allowed categories.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"keys": schema.ListAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -139,6 +209,45 @@ func (r *RedisAclUserResource) Schema(ctx context.Context, req resource.SchemaRe
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"allkeys": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user can access all keys (allkeys rule, equivalent to ~*).",
+			},
+			"allchannels": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user can access all Pub/Sub channels (allchannels rule, equivalent to &*).",
+			},
+			"resetkeys": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to emit the resetkeys rule before the key patterns. Not reported by Redis, so it is kept from the configuration.",
+			},
+			"resetchannels": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to emit the resetchannels rule before the channel patterns. Not reported by Redis, so it is kept from the configuration.",
+			},
+			"nopass": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user can authenticate with any password. Conflicts with password_wo.",
+			},
+			"previous_password_expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC 3339 time at which the previous password kept by password_rotation stops being accepted, or null when no previous password is kept or it is only limited by keep_previous_applies.",
+			},
+			"rules": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Raw ACL rules applied verbatim and in order after reset (e.g. '+@all', '-flushall', '+flushall|async'). Conflicts with the structured permission attributes and selector blocks; passwords and on/off are still managed by password_wo and enabled.",
+			},
 			"acl_save": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Whether to save the ACL user configuration.",
@@ -146,6 +255,110 @@ func (r *RedisAclUserResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"selector": schema.ListNestedBlock{
+				Description: "Redis 7 selector granting an additional, independent set of permissions. Each block is rendered as a parenthesised selector rule.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"commands": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "ACL commands allowed by the selector (without + prefix).",
+						},
+						"excluded_commands": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "ACL commands denied by the selector (without - prefix).",
+						},
+						"categories": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "ACL categories allowed by the selector (without +@ prefix).",
+						},
+						"excluded_categories": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "ACL categories denied by the selector (without -@ prefix).",
+						},
+						"keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Key patterns the selector can access (without ~ prefix).",
+						},
+						"readonly_keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Key patterns the selector can only read (without %R~ prefix).",
+						},
+						"writeonly_keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Key patterns the selector can only write (without %W~ prefix).",
+						},
+						"channels": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Pub/Sub channel patterns the selector can access (without & prefix).",
+						},
+					},
+				},
+			},
+			"assert": schema.ListNestedBlock{
+				Description: "Permission check run with ACL DRYRUN after the user is written. If any assertion does not hold, the user is rolled back to its previous rules and the apply fails. Requires Redis 7.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Required:    true,
+							Description: "Command to dry run as the user (e.g. 'get').",
+						},
+						"args": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Arguments of the command (e.g. ['cache:1']).",
+						},
+						"allowed": schema.BoolAttribute{
+							Required:    true,
+							Description: "Whether the user is expected to be allowed to run the command.",
+						},
+					},
+				},
+			},
+			"password": schema.ListNestedBlock{
+				Description: "Additional password of the user, so services sharing a user can each hold their own credential. Each block is rotated independently by changing its version.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Unique name identifying the password, such as the service holding it.",
+						},
+						"value_wo": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+							Description: "Write-only password. It is hashed with SHA256 before being stored in Redis.",
+						},
+						"version": schema.StringAttribute{
+							Required:    true,
+							Description: "Version string for the password. Changing it replaces this password only.",
+						},
+					},
+				},
+			},
+			"password_rotation": schema.SingleNestedBlock{
+				Description: "Keeps the previous password valid alongside the new one when password_wo_version changes, so clients can move to the new secret before the old one is removed on a later apply.",
+				Attributes: map[string]schema.Attribute{
+					"keep_previous_applies": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Number of applies after a rotation during which the previous password is kept. Every plan shows an update until it is removed.",
+					},
+					"keep_previous_for": schema.StringAttribute{
+						Optional:    true,
+						Description: "Duration after a rotation during which the previous password is kept (e.g. '24h'). The first plan after it elapses removes it.",
+					},
+				},
+			},
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
 
@@ -161,25 +374,57 @@ func (r *RedisAclUserResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	if _, err := r.AclGetUser(plan.Name.ValueString(), ctx); err == nil {
-		resp.Diagnostics.AddError("User already exists", fmt.Sprintf("ACL user '%s' already exists, consider importing it", plan.Name.ValueString()))
+	ctx, cancel, err := plan.Timeouts.withTimeout(ctx, "create")
+	defer cancel()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("create"), "Invalid timeout", err.Error())
 		return
 	}
 
-	if config.PasswordWo.IsNull() && config.PasswordWo.IsUnknown() {
-		resp.Diagnostics.AddError("Failed to create ACL user", "password_wo is null or empty")
+	switch _, err := r.AclGetUser(plan.Name.ValueString(), ctx); {
+	case err == nil:
+		resp.Diagnostics.AddError("User already exists", fmt.Sprintf("ACL user '%s' already exists, consider importing it", plan.Name.ValueString()))
+		return
+	case !errors.Is(err, redis.Nil):
+		resp.Diagnostics.AddError("Failed to check for existing ACL user", err.Error())
 		return
 	}
 
-	passwordHash := hashPassword(config.PasswordWo.ValueString())
+	passwordHashes := []string{}
+	var entryHashes []string
+	if !plan.NoPass.ValueBool() {
+		if passwordHash, ok := configPasswordHash(&config); ok {
+			passwordHashes = append(passwordHashes, passwordHash)
+		}
+		entryHashes = resolvePasswordEntryHashes(plan.Passwords, config.Passwords, nil, nil)
+		if len(passwordHashes)+len(entryHashes) == 0 {
+			resp.Diagnostics.AddError("Failed to create ACL user", "password_wo and password_hash_wo are null and no password block is set")
+			return
+		}
+	}
 
-	_, err := r.AclSetUser(&plan, ctx, []string{passwordHash})
+	plan.PreviousPasswordExpiresAt = types.StringNull()
 
-	if err != nil {
+	if _, err := r.AclSetUser(&plan, ctx, append(passwordHashes, entryHashes...)); err != nil {
 		resp.Diagnostics.AddError("Failed to create ACL user", err.Error())
 		return
 	}
 
+	if diags := r.verifyAssertions(ctx, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		if _, err := r.AclDelUser(plan.Name.ValueString(), ctx, plan.AclSave.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Failed to roll back ACL user", err.Error())
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshAppliedUser(ctx, &plan, resp.Private)...)
+	resp.Diagnostics.Append(setPrivatePasswords(ctx, resp.Private, appliedPasswordHashes(&plan, passwordHashes))...)
+	resp.Diagnostics.Append(setPrivatePasswordEntries(ctx, resp.Private, plan.Passwords, entryHashes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -191,9 +436,22 @@ func (r *RedisAclUserResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	aclData, err := r.AclGetUser(state.Name.ValueString(), ctx)
+	// The provider is left unconfigured while connection attributes are
+	// unknown during plan; keep the prior state until they are known.
+	if r.providerData == nil {
+		return
+	}
+
+	ctx, cancel, err := state.Timeouts.withTimeout(ctx, "read")
+	defer cancel()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("read"), "Invalid timeout", err.Error())
+		return
+	}
+
+	nodesData, err := r.AclGetUserFromNodes(state.Name.ValueString(), ctx)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, redis.Nil) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -201,16 +459,77 @@ func (r *RedisAclUserResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	aclMap := parseAclDataToMap(aclData)
+	aclMap := selectNodeAclMap(ctx, nodesData, state, &resp.Diagnostics)
+	if aclMap == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err := loadAclMapIntoState(ctx, aclMap, &state, &resp.Diagnostics); err != nil {
 		resp.Diagnostics.AddError("Failed to load ACL user data", err.Error())
 		return
 	}
 
+	if !state.NoPass.ValueBool() {
+		readPasswordDrift(ctx, &state, parsePasswordHashesFromAclMap(aclMap), resp.Private, &resp.Diagnostics)
+	}
+
+	if !state.Rules.IsNull() {
+		// Selectors written through rules are tracked by the rules attribute.
+		state.Selectors = nil
+		resp.Diagnostics.Append(r.readRules(ctx, &state, resp.Private)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// readPasswordDrift compares the password hashes on the server with those the
+// provider applied last. A null version makes the plan show a password update,
+// so drift is surfaced on password_wo_version or on the affected password
+// blocks.
+func readPasswordDrift(ctx context.Context, state *RedisAclUserResourceModel, serverHashes []string, private privateState, diags *diag.Diagnostics) {
+	stored, d := getPrivatePasswords(ctx, private)
+	diags.Append(d...)
+	entries, d := getPrivatePasswordEntries(ctx, private)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if entries != nil {
+		for i, entry := range state.Passwords {
+			if entries.changed(entry.Name.ValueString(), serverHashes) {
+				diags.AddWarning("ACL user password changed outside Terraform", fmt.Sprintf("The password '%s' of ACL user '%s' was removed outside Terraform; the next apply will set it again", entry.Name.ValueString(), state.Name.ValueString()))
+				state.Passwords[i].Version = types.StringNull()
+			}
+		}
+	}
+	if stored == nil {
+		return
+	}
+
+	// Hashes of password blocks were checked above; the record only holds
+	// those applied for password_wo or password_hash_wo.
+	hashes := slices.DeleteFunc(slices.Clone(serverHashes), func(hash string) bool {
+		return entries != nil && entries.owns(hash) && !stored.contains(hash)
+	})
+	if stored.matches(hashes) {
+		return
+	}
+	if !state.PasswordWoVersion.IsNull() {
+		diags.AddWarning("ACL user password changed outside Terraform", fmt.Sprintf("The passwords of ACL user '%s' no longer match password_wo; the next apply will set it again", state.Name.ValueString()))
+		state.PasswordWoVersion = types.StringNull()
+		return
+	}
+	diags.AddWarning("ACL user password changed outside Terraform", fmt.Sprintf("ACL user '%s' has passwords that are not set by its password blocks; the next apply will remove them", state.Name.ValueString()))
+	for i := range state.Passwords {
+		state.Passwords[i].Version = types.StringNull()
+	}
+}
+
 func (r *RedisAclUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state, config RedisAclUserResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -220,9 +539,16 @@ func (r *RedisAclUserResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel, err := plan.Timeouts.withTimeout(ctx, "update")
+	defer cancel()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("update"), "Invalid timeout", err.Error())
+		return
+	}
+
 	aclData, err := r.AclGetUser(state.Name.ValueString(), ctx)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, redis.Nil) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -232,20 +558,74 @@ func (r *RedisAclUserResource) Update(ctx context.Context, req resource.UpdateRe
 
 	aclMap := parseAclDataToMap(aclData)
 
-	passwordHashes := parsePasswordHashesFromAclMap(aclMap)
+	serverHashes := parsePasswordHashesFromAclMap(aclMap)
+
+	applied, diags := getPrivatePasswords(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := getPrivatePreviousPasswords(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	entries, diags := getPrivatePasswordEntries(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hashes of password blocks, current or replaced, are handled apart from
+	// password_wo and its rotation.
+	entryHashes := resolvePasswordEntryHashes(plan.Passwords, config.Passwords, serverHashes, entries)
+	serverHashes = slices.DeleteFunc(serverHashes, func(hash string) bool {
+		return slices.Contains(entryHashes, hash) || (entries != nil && entries.owns(hash))
+	})
 
-	if plan.PasswordWoVersion.ValueString() != state.PasswordWoVersion.ValueString() {
-		if !config.PasswordWo.IsNull() && !config.PasswordWo.IsUnknown() {
-			passwordHash := hashPassword(config.PasswordWo.ValueString())
-			passwordHashes = []string{passwordHash}
+	now := time.Now()
+	passwordHashes, previous := expirePreviousPasswords(serverHashes, previous, now)
+
+	// A user leaving nopass has no stored password left to keep.
+	if plan.PasswordWoVersion.ValueString() != state.PasswordWoVersion.ValueString() || len(serverHashes) == 0 {
+		if passwordHash, ok := configPasswordHash(&config); ok {
+			var kept []string
+			passwordHashes, kept = rotatePasswordHashes(passwordHash, serverHashes, applied, previous, plan.PasswordRotation)
+			previous = nil
+			if len(kept) > 0 {
+				if previous, err = newPreviousPasswords(kept, plan.PasswordRotation, now); err != nil {
+					resp.Diagnostics.AddAttributeError(path.Root("password_rotation"), "Invalid password rotation", err.Error())
+					return
+				}
+			}
 		}
 	}
+	if config.PasswordWo.IsNull() && config.PasswordHashWo.IsNull() {
+		passwordHashes, previous = []string{}, nil
+	}
+	plan.PreviousPasswordExpiresAt = previousPasswordExpiresAt(previous)
 
-	if _, err := r.AclSetUser(&plan, ctx, passwordHashes); err != nil {
+	previousRules, err := r.AclListUserRules(state.Name.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read ACL user", err.Error())
+		return
+	}
+
+	if _, err := r.AclSetUser(&plan, ctx, append(passwordHashes, entryHashes...)); err != nil {
 		resp.Diagnostics.AddError("Failed to update ACL user", err.Error())
 		return
 	}
 
+	if diags := r.verifyAssertions(ctx, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		if _, err := r.AclRestoreUser(plan.Name.ValueString(), ctx, previousRules, plan.AclSave.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Failed to roll back ACL user", err.Error())
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshAppliedUser(ctx, &plan, resp.Private)...)
+	resp.Diagnostics.Append(setPrivatePasswords(ctx, resp.Private, appliedPasswordHashes(&plan, passwordHashes))...)
+	resp.Diagnostics.Append(setPrivatePreviousPasswords(ctx, resp.Private, previous)...)
+	resp.Diagnostics.Append(setPrivatePasswordEntries(ctx, resp.Private, plan.Passwords, entryHashes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -256,34 +636,389 @@ func (r *RedisAclUserResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err := r.AclDelUser(state.Name.ValueString(), ctx, state.AclSave.ValueBool())
+	ctx, cancel, err := state.Timeouts.withTimeout(ctx, "delete")
+	defer cancel()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("delete"), "Invalid timeout", err.Error())
+		return
+	}
+
+	_, err = r.AclDelUser(state.Name.ValueString(), ctx, state.AclSave.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete ACL user", err.Error())
 		return
 	}
 }
 
+func (r *RedisAclUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RedisAclUserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateRulesConfig(&config, &resp.Diagnostics)
+	validatePasswordConfig(&config, &resp.Diagnostics)
+	validatePasswordRotationConfig(config.PasswordRotation, &resp.Diagnostics)
+	config.Timeouts.validate(&resp.Diagnostics)
+}
+
+func validatePasswordRotationConfig(rotation *RedisAclPasswordRotationModel, diags *diag.Diagnostics) {
+	if rotation == nil || rotation.KeepPreviousApplies.IsUnknown() || rotation.KeepPreviousFor.IsUnknown() {
+		return
+	}
+	if rotation.KeepPreviousApplies.IsNull() && rotation.KeepPreviousFor.IsNull() {
+		diags.AddAttributeError(path.Root("password_rotation"), "Missing grace window", "password_rotation requires keep_previous_applies, keep_previous_for or both")
+	}
+	if !rotation.KeepPreviousApplies.IsNull() && rotation.KeepPreviousApplies.ValueInt64() < 1 {
+		diags.AddAttributeError(path.Root("password_rotation").AtName("keep_previous_applies"), "Invalid grace window", "keep_previous_applies must be at least 1")
+	}
+	if v := rotation.KeepPreviousFor.ValueString(); !rotation.KeepPreviousFor.IsNull() {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			diags.AddAttributeError(path.Root("password_rotation").AtName("keep_previous_for"), "Invalid grace window", fmt.Sprintf("keep_previous_for must be a positive duration such as '24h', got %q", v))
+		}
+	}
+}
+
+// previousPasswordExpiresAt renders the expiry of the previous passwords for
+// state.
+func previousPasswordExpiresAt(previous *previousPasswords) types.String {
+	if previous == nil || previous.ExpiresAt == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(previous.ExpiresAt.Format(time.RFC3339))
+}
+
+// ModifyPlan checks categories and commands against the live server so typos
+// are reported at plan time instead of failing ACL SETUSER during apply.
+func (r *RedisAclUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var plan RedisAclUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		previous, diags := getPrivatePreviousPasswords(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if previous != nil && previous.needsApply(time.Now()) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_password_expires_at"), types.StringUnknown())...)
+		}
+	}
+
+	client, err := r.redisClient()
+	if err != nil {
+		return
+	}
+
+	categories, err := aclCategories(ctx, client)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate ACL rules", fmt.Sprintf("ACL CAT failed: %s", err))
+		return
+	}
+	commands, err := aclCommandInfo(ctx, client, aclCommandNames(&plan))
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate ACL rules", fmt.Sprintf("COMMAND INFO failed: %s", err))
+		return
+	}
+
+	validateAclNames(&plan, categories, commands, &resp.Diagnostics)
+}
+
+// validatePasswordConfig rejects duplicate password block names, password_wo
+// combined with password_hash_wo, and malformed hashes. Unless nopass is true
+// it requires password_wo, password_hash_wo or a password block, with
+// password_wo_version alongside either attribute; with nopass none may be set.
+func validatePasswordConfig(config *RedisAclUserResourceModel, diags *diag.Diagnostics) {
+	names := map[string]bool{}
+	for i, entry := range config.Passwords {
+		if entry.Name.IsUnknown() || entry.Name.IsNull() {
+			continue
+		}
+		if names[entry.Name.ValueString()] {
+			diags.AddAttributeError(path.Root("password").AtListIndex(i).AtName("name"), "Duplicate password name", fmt.Sprintf("password name %q is used more than once", entry.Name.ValueString()))
+		}
+		names[entry.Name.ValueString()] = true
+	}
+
+	if !config.PasswordWo.IsNull() && !config.PasswordHashWo.IsNull() {
+		diags.AddAttributeError(path.Root("password_hash_wo"), "Conflicting ACL attributes", "password_hash_wo cannot be set together with password_wo")
+	}
+	if v := config.PasswordHashWo; !v.IsNull() && !v.IsUnknown() && !isPasswordHash(v.ValueString()) {
+		diags.AddAttributeError(path.Root("password_hash_wo"), "Invalid password hash", "password_hash_wo must be a SHA256 hash of 64 hexadecimal characters")
+	}
+
+	if config.NoPass.IsUnknown() || config.PasswordWo.IsUnknown() || config.PasswordHashWo.IsUnknown() {
+		return
+	}
+	hasPassword := !config.PasswordWo.IsNull() || !config.PasswordHashWo.IsNull()
+	if config.NoPass.ValueBool() && !config.PasswordWo.IsNull() {
+		diags.AddAttributeError(path.Root("password_wo"), "Conflicting ACL attributes", "password_wo cannot be set when nopass is true")
+	}
+	if config.NoPass.ValueBool() && !config.PasswordHashWo.IsNull() {
+		diags.AddAttributeError(path.Root("password_hash_wo"), "Conflicting ACL attributes", "password_hash_wo cannot be set when nopass is true")
+	}
+	if config.NoPass.ValueBool() && len(config.Passwords) > 0 {
+		diags.AddAttributeError(path.Root("password"), "Conflicting ACL attributes", "password blocks cannot be set when nopass is true")
+	}
+	if !config.NoPass.ValueBool() && !hasPassword && len(config.Passwords) == 0 {
+		diags.AddAttributeError(path.Root("password_wo"), "Missing password", "password_wo, password_hash_wo or a password block is required unless nopass is true")
+	}
+	if hasPassword && config.PasswordWoVersion.IsNull() {
+		diags.AddAttributeError(path.Root("password_wo_version"), "Missing password version", "password_wo_version is required when password_wo or password_hash_wo is set")
+	}
+}
+
+// configPasswordHash returns the hash of password_wo, or password_hash_wo as
+// is, and whether either is set.
+func configPasswordHash(config *RedisAclUserResourceModel) (string, bool) {
+	if !config.PasswordHashWo.IsNull() && !config.PasswordHashWo.IsUnknown() {
+		return strings.ToLower(config.PasswordHashWo.ValueString()), true
+	}
+	if !config.PasswordWo.IsNull() && !config.PasswordWo.IsUnknown() {
+		return hashPassword(config.PasswordWo.ValueString()), true
+	}
+	return "", false
+}
+
+func isPasswordHash(value string) bool {
+	if len(value) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
+
+// validateRulesConfig rejects raw rules combined with the structured
+// permission attributes, and rules that would bypass password_wo or enabled.
+func validateRulesConfig(config *RedisAclUserResourceModel, diags *diag.Diagnostics) {
+	if config.Rules.IsNull() {
+		return
+	}
+
+	structured := map[string]types.List{
+		"commands":            config.Commands,
+		"excluded_commands":   config.ExcludedCommands,
+		"categories":          config.Categories,
+		"excluded_categories": config.ExcludedCategories,
+		"keys":                config.Keys,
+		"readonly_keys":       config.ReadonlyKeys,
+		"writeonly_keys":      config.WriteonlyKeys,
+		"channels":            config.Channels,
+	}
+	for _, name := range slices.Sorted(maps.Keys(structured)) {
+		if !structured[name].IsNull() {
+			diags.AddAttributeError(path.Root(name), "Conflicting ACL attributes", fmt.Sprintf("%s cannot be set together with rules", name))
+		}
+	}
+	flags := map[string]types.Bool{
+		"allkeys":       config.AllKeys,
+		"allchannels":   config.AllChannels,
+		"resetkeys":     config.ResetKeys,
+		"resetchannels": config.ResetChannels,
+	}
+	for _, name := range slices.Sorted(maps.Keys(flags)) {
+		if !flags[name].IsNull() {
+			diags.AddAttributeError(path.Root(name), "Conflicting ACL attributes", fmt.Sprintf("%s cannot be set together with rules; write it as a rule instead", name))
+		}
+	}
+	if len(config.Selectors) > 0 {
+		diags.AddAttributeError(path.Root("selector"), "Conflicting ACL attributes", "selector blocks cannot be set together with rules; write selectors as parenthesised rules instead")
+	}
+
+	for i, rule := range toStringList(config.Rules) {
+		if rule.IsUnknown() || rule.IsNull() {
+			continue
+		}
+		if isPasswordOrStateRule(rule.ValueString()) {
+			diags.AddAttributeError(path.Root("rules").AtListIndex(i), "Unsupported ACL rule", fmt.Sprintf("rule %q is managed by password_wo or enabled and cannot be used in rules", rule.ValueString()))
+		}
+	}
+}
+
+// verifyAssertions dry runs every assert block as the user and reports the
+// ones that do not hold at the path of the block.
+func (r *RedisAclUserResource) verifyAssertions(ctx context.Context, plan *RedisAclUserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(plan.Asserts) == 0 {
+		return diags
+	}
+
+	client, err := r.redisClient()
+	if err != nil {
+		diags.AddError("Failed to verify ACL assertions", err.Error())
+		return diags
+	}
+
+	for i := range plan.Asserts {
+		a := &plan.Asserts[i]
+		args := []string{}
+		for _, arg := range toStringList(a.Args) {
+			args = append(args, arg.ValueString())
+		}
+		allowed, reason, err := aclDryRun(ctx, client, plan.Name.ValueString(), a.Command.ValueString(), args)
+		if err != nil {
+			diags.AddAttributeError(path.Root("assert").AtListIndex(i), "Failed to verify ACL assertion", err.Error())
+			continue
+		}
+		if failure := assertionFailure(a, allowed, reason); failure != "" {
+			diags.AddAttributeError(path.Root("assert").AtListIndex(i), "ACL assertion failed", failure+"; the ACL user was rolled back")
+		}
+	}
+	return diags
+}
+
+// refreshAppliedUser reads the user back after ACL SETUSER. Computed lists
+// left unknown by the plan are filled from the server, and when raw rules
+// are used their canonical ACL LIST form is kept in private state so Read
+// can tell server-side changes from Redis' own rewriting of the rules.
+func (r *RedisAclUserResource) refreshAppliedUser(ctx context.Context, plan *RedisAclUserResourceModel, private privateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	aclData, err := r.AclGetUser(plan.Name.ValueString(), ctx)
+	if err != nil {
+		diags.AddError("Failed to read ACL user", err.Error())
+		return diags
+	}
+	loaded := *plan
+	_ = loadAclMapIntoState(ctx, parseAclDataToMap(aclData), &loaded, &diags)
+	resolveUnknownLists(plan, &loaded)
+
+	if plan.Rules.IsNull() {
+		diags.Append(setPrivateRules(ctx, private, nil)...)
+		return diags
+	}
+
+	nodesRules, err := r.AclListRulesFromNodes(plan.Name.ValueString(), ctx)
+	if err != nil {
+		diags.AddError("Failed to read ACL rules", err.Error())
+		return diags
+	}
+	for _, rules := range nodesRules {
+		if rules != nil {
+			diags.Append(setPrivateRules(ctx, private, rules)...)
+			break
+		}
+	}
+	return diags
+}
+
+// readRules keeps the configured rules in state while the server still holds
+// the canonical rules recorded at apply time. Otherwise the server's rules
+// replace them so the plan shows the drift.
+func (r *RedisAclUserResource) readRules(ctx context.Context, state *RedisAclUserResourceModel, private privateState) diag.Diagnostics {
+	nodesRules, err := r.AclListRulesFromNodes(state.Name.ValueString(), ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to read ACL rules", err.Error())
+		return diags
+	}
+
+	stored, diags := getPrivateRules(ctx, private)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, rules := range nodesRules {
+		if rules == nil {
+			continue
+		}
+		if stored == nil {
+			stored = rules
+			diags.Append(setPrivateRules(ctx, private, rules)...)
+			continue
+		}
+		if !slices.Equal(rules, stored) {
+			state.Rules, _ = convertToTypesList(ctx, rules, &diags)
+			return diags
+		}
+	}
+	return diags
+}
+
+// resolveUnknownLists replaces the computed lists that are still unknown in
+// plan with the values loaded from the server.
+func resolveUnknownLists(plan *RedisAclUserResourceModel, loaded *RedisAclUserResourceModel) {
+	pairs := [][2]*types.List{
+		{&plan.Commands, &loaded.Commands},
+		{&plan.ExcludedCommands, &loaded.ExcludedCommands},
+		{&plan.Categories, &loaded.Categories},
+		{&plan.ExcludedCategories, &loaded.ExcludedCategories},
+		{&plan.Keys, &loaded.Keys},
+		{&plan.ReadonlyKeys, &loaded.ReadonlyKeys},
+		{&plan.WriteonlyKeys, &loaded.WriteonlyKeys},
+		{&plan.Channels, &loaded.Channels},
+	}
+	for _, pair := range pairs {
+		if pair[0].IsUnknown() {
+			*pair[0] = *pair[1]
+		}
+	}
+}
+
 func (r *RedisAclUserResource) AclGetUser(username string, ctx context.Context) (map[any]any, error) {
-	client := r.redisClient()
-	res, err := client.Do(ctx, "ACL", "GETUSER", username).Result()
+	client, err := r.redisClient()
 	if err != nil {
 		return nil, err
 	}
+	return aclGetUser(ctx, client, username)
+}
 
-	aclData, ok := res.(map[any]any)
-	if !ok {
-		return nil, fmt.Errorf("unexpected response format from Redis")
+func (r *RedisAclUserResource) AclGetUserFromNodes(username string, ctx context.Context) ([]map[any]any, error) {
+	client, err := r.redisClient()
+	if err != nil {
+		return nil, err
+	}
+	return aclGetUserFromNodes(ctx, client, username)
+}
+
+func (r *RedisAclUserResource) AclListRulesFromNodes(username string, ctx context.Context) ([][]string, error) {
+	client, err := r.redisClient()
+	if err != nil {
+		return nil, err
 	}
-	return aclData, nil
+	return aclListRulesFromNodes(ctx, client, username)
+}
+
+func (r *RedisAclUserResource) AclListUserRules(username string, ctx context.Context) ([]string, error) {
+	client, err := r.redisClient()
+	if err != nil {
+		return nil, err
+	}
+	return aclListUserRules(ctx, client, username)
+}
+
+func (r *RedisAclUserResource) AclRestoreUser(username string, ctx context.Context, rules []string, saveChanges bool) (bool, error) {
+	client, err := r.redisClient()
+	if err != nil {
+		return false, err
+	}
+	if err := restoreAclUser(ctx, client, username, rules); err != nil {
+		return false, err
+	}
+	if saveChanges {
+		if _, err := r.AclSave(ctx); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
 }
 
 func (r *RedisAclUserResource) AclSetUser(model *RedisAclUserResourceModel, ctx context.Context, hashedPasswords []string) (bool, error) {
-	client := r.redisClient()
+	client, err := r.redisClient()
+	if err != nil {
+		return false, err
+	}
 	rules := buildACLRules(model, hashedPasswords)
 	username := model.Name.ValueString()
 	args := append([]any{"ACL", "SETUSER", username}, toAny(rules)...)
 
-	if err := client.Do(ctx, args...).Err(); err != nil {
+	if err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		return node.Do(ctx, args...).Err()
+	}); err != nil {
 		return false, err
 	}
 	if model.AclSave.ValueBool() {
@@ -295,8 +1030,13 @@ func (r *RedisAclUserResource) AclSetUser(model *RedisAclUserResourceModel, ctx
 }
 
 func (r *RedisAclUserResource) AclDelUser(username string, ctx context.Context, saveChanges bool) (bool, error) {
-	client := r.redisClient()
-	if err := client.Do(ctx, "ACL", "DELUSER", username).Err(); err != nil {
+	client, err := r.redisClient()
+	if err != nil {
+		return false, err
+	}
+	if err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		return node.Do(ctx, "ACL", "DELUSER", username).Err()
+	}); err != nil {
 		return false, err
 	}
 	if saveChanges {
@@ -308,9 +1048,13 @@ func (r *RedisAclUserResource) AclDelUser(username string, ctx context.Context,
 }
 
 func (r *RedisAclUserResource) AclSave(ctx context.Context) (bool, error) {
-	client := r.redisClient()
-
-	if err := client.Do(ctx, "ACL", "SAVE").Err(); err != nil {
+	client, err := r.redisClient()
+	if err != nil {
+		return false, err
+	}
+	if err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		return node.Do(ctx, "ACL", "SAVE").Err()
+	}); err != nil {
 		return false, err
 	}
 	return true, nil
@@ -324,10 +1068,57 @@ func parseAclDataToMap(aclData map[any]any) map[string]any {
 	return aclMap
 }
 
+// selectNodeAclMap picks the ACL data Read should load into state. When nodes
+// disagree, the data of a node whose rules differ from the current state is
+// returned so the plan shows drift and the next apply converges every node.
+// Nodes the user is missing on count as an empty user. It returns nil when
+// the user does not exist on any node.
+func selectNodeAclMap(ctx context.Context, nodesData []map[any]any, state RedisAclUserResourceModel, diags *diag.Diagnostics) map[string]any {
+	aclMaps := make([]map[string]any, len(nodesData))
+	var found map[string]any
+	for i, aclData := range nodesData {
+		if aclData == nil {
+			aclMaps[i] = map[string]any{}
+			continue
+		}
+		aclMaps[i] = parseAclDataToMap(aclData)
+		if found == nil {
+			found = aclMaps[i]
+		}
+	}
+	if found == nil {
+		return nil
+	}
+
+	consistent := true
+	for _, aclMap := range aclMaps[1:] {
+		if !reflect.DeepEqual(aclMap, aclMaps[0]) {
+			consistent = false
+			break
+		}
+	}
+	if consistent {
+		return found
+	}
+
+	diags.AddWarning("ACL user differs between nodes", fmt.Sprintf("ACL user '%s' is not identical on every cluster node; it will be reapplied to all nodes", state.Name.ValueString()))
+
+	expected := buildACLRules(&state, nil)
+	for _, aclMap := range aclMaps {
+		nodeState := state
+		_ = loadAclMapIntoState(ctx, aclMap, &nodeState, diags)
+		if !slices.Equal(buildACLRules(&nodeState, nil), expected) {
+			return aclMap
+		}
+	}
+	return found
+}
+
 func loadAclMapIntoState(ctx context.Context, aclMap map[string]any, state *RedisAclUserResourceModel, diags *diag.Diagnostics) error {
 
 	enabled := parseEnabledFromFlags(aclMap)
 	state.Enabled = types.BoolValue(enabled)
+	state.NoPass = types.BoolValue(parseFlagFromAclMap(aclMap, "nopass"))
 
 	categories, commands, excludedCommands := parseCommandsFromAclMap(aclMap)
 	if commandsList, err := convertToTypesList(ctx, commands, diags); err == nil {
@@ -342,7 +1133,15 @@ func loadAclMapIntoState(ctx context.Context, aclMap map[string]any, state *Redi
 		state.Categories = categoriesList
 	}
 
+	excludedCategories := parseExcludedCategoriesFromAclMap(aclMap)
+	if excludedCategoriesList, err := convertToTypesList(ctx, excludedCategories, diags); err == nil {
+		state.ExcludedCategories = excludedCategoriesList
+	}
+
 	keys, readonlyKeys, writeonlyKeys := parseKeysFromAclMap(aclMap)
+	allKeys := parseFlagFromAclMap(aclMap, "allkeys")
+	keys, allKeys = splitWildcardPattern(keys, allKeys, state.AllKeys.ValueBool())
+	state.AllKeys = types.BoolValue(allKeys)
 	if keyList, err := convertToTypesList(ctx, keys, diags); err == nil {
 		state.Keys = keyList
 	}
@@ -354,13 +1153,70 @@ func loadAclMapIntoState(ctx context.Context, aclMap map[string]any, state *Redi
 	}
 
 	channels := parseChannelsFromAclMap(aclMap)
+	allChannels := parseFlagFromAclMap(aclMap, "allchannels")
+	channels, allChannels = splitWildcardPattern(channels, allChannels, state.AllChannels.ValueBool())
+	state.AllChannels = types.BoolValue(allChannels)
 	if channelList, err := convertToTypesList(ctx, channels, diags); err == nil {
 		state.Channels = channelList
 	}
 
+	selectors := []RedisAclSelectorModel{}
+	for i, selectorMap := range parseSelectorsFromAclMap(aclMap) {
+		var prior *RedisAclSelectorModel
+		if i < len(state.Selectors) {
+			prior = &state.Selectors[i]
+		}
+		selector := loadAclMapIntoSelectorModel(ctx, selectorMap, diags)
+		nullEmptySelectorLists(prior, &selector)
+		selectors = append(selectors, selector)
+	}
+	state.Selectors = selectors
+
 	return nil
 }
 
+// loadAclMapIntoSelectorModel reads the commands, keys and channels fields,
+// which have the same format at the top level of ACL GETUSER and in each
+// of its selectors.
+func loadAclMapIntoSelectorModel(ctx context.Context, aclMap map[string]any, diags *diag.Diagnostics) RedisAclSelectorModel {
+	var selector RedisAclSelectorModel
+
+	categories, commands, excludedCommands := parseCommandsFromAclMap(aclMap)
+	selector.Categories, _ = convertToTypesList(ctx, categories, diags)
+	selector.Commands, _ = convertToTypesList(ctx, commands, diags)
+	selector.ExcludedCommands, _ = convertToTypesList(ctx, excludedCommands, diags)
+	selector.ExcludedCategories, _ = convertToTypesList(ctx, parseExcludedCategoriesFromAclMap(aclMap), diags)
+
+	keys, readonlyKeys, writeonlyKeys := parseKeysFromAclMap(aclMap)
+	selector.Keys, _ = convertToTypesList(ctx, keys, diags)
+	selector.ReadonlyKeys, _ = convertToTypesList(ctx, readonlyKeys, diags)
+	selector.WriteonlyKeys, _ = convertToTypesList(ctx, writeonlyKeys, diags)
+
+	selector.Channels, _ = convertToTypesList(ctx, parseChannelsFromAclMap(aclMap), diags)
+
+	return selector
+}
+
+// nullEmptySelectorLists turns empty selector lists back into null unless the
+// prior state held an explicit empty list, since selector attributes are not
+// computed and an omitted attribute must read back as null.
+func nullEmptySelectorLists(prior *RedisAclSelectorModel, selector *RedisAclSelectorModel) {
+	var priorLists []types.List
+	if prior != nil {
+		priorLists = []types.List{prior.Commands, prior.ExcludedCommands, prior.Categories, prior.ExcludedCategories, prior.Keys, prior.ReadonlyKeys, prior.WriteonlyKeys, prior.Channels}
+	}
+	lists := []*types.List{&selector.Commands, &selector.ExcludedCommands, &selector.Categories, &selector.ExcludedCategories, &selector.Keys, &selector.ReadonlyKeys, &selector.WriteonlyKeys, &selector.Channels}
+	for i, list := range lists {
+		if len(list.Elements()) > 0 {
+			continue
+		}
+		if priorLists != nil && !priorLists[i].IsNull() {
+			continue
+		}
+		*list = types.ListNull(types.StringType)
+	}
+}
+
 func parsePasswordHashesFromAclMap(aclMap map[string]any) []string {
 	passwordsData, ok := aclMap["passwords"].([]any)
 	if !ok {
@@ -376,19 +1232,37 @@ func parsePasswordHashesFromAclMap(aclMap map[string]any) []string {
 }
 
 func parseEnabledFromFlags(aclMap map[string]any) bool {
+	return parseFlagFromAclMap(aclMap, "on")
+}
+
+func parseFlagFromAclMap(aclMap map[string]any, name string) bool {
 	flags, ok := aclMap["flags"].([]any)
 	if !ok {
 		return false
 	}
 
 	for _, flag := range flags {
-		if flagStr, ok := flag.(string); ok && flagStr == "on" {
+		if flagStr, ok := flag.(string); ok && flagStr == name {
 			return true
 		}
 	}
 	return false
 }
 
+// splitWildcardPattern reconciles the "*" pattern with the allkeys or
+// allchannels flag. Redis 7 no longer reports these flags and shows the
+// wildcard pattern instead, so "*" is attributed to the flag when Redis
+// reports it or the prior state had it set, and kept as a pattern otherwise.
+func splitWildcardPattern(patterns []string, flag bool, priorFlag bool) ([]string, bool) {
+	if !slices.Contains(patterns, "*") {
+		return patterns, flag
+	}
+	if !flag && !priorFlag {
+		return patterns, false
+	}
+	return slices.DeleteFunc(slices.Clone(patterns), func(p string) bool { return p == "*" }), true
+}
+
 func parseCommandsFromAclMap(aclMap map[string]any) (
 	categories []string,
 	commands []string,
@@ -419,6 +1293,23 @@ func parseCommandsFromAclMap(aclMap map[string]any) (
 	return
 }
 
+// parseExcludedCategoriesFromAclMap returns the -@ categories of the commands
+// field. -@all is skipped since every rule set starts from it after reset.
+func parseExcludedCategoriesFromAclMap(aclMap map[string]any) []string {
+	commandsData, ok := aclMap["commands"].(string)
+	if !ok || commandsData == "" {
+		return []string{}
+	}
+
+	excludedCategories := []string{}
+	for _, token := range strings.Split(commandsData, " ") {
+		if category, ok := strings.CutPrefix(token, "-@"); ok && category != "all" {
+			excludedCategories = append(excludedCategories, category)
+		}
+	}
+	return excludedCategories
+}
+
 func parseKeysFromAclMap(aclMap map[string]any) (keys, readonlyKeys, writeonlyKeys []string) {
 	keysDataStr, ok := aclMap["keys"].(string)
 	keysData := strings.Split(keysDataStr, " ")
@@ -461,6 +1352,25 @@ func parseChannelsFromAclMap(aclMap map[string]any) []string {
 	return channelStrs
 }
 
+// parseSelectorsFromAclMap returns the selectors of an ACL GETUSER reply as
+// maps with the same commands, keys and channels fields as the reply itself.
+func parseSelectorsFromAclMap(aclMap map[string]any) []map[string]any {
+	selectorsData, ok := aclMap["selectors"].([]any)
+	if !ok {
+		return []map[string]any{}
+	}
+	selectors := []map[string]any{}
+	for _, selector := range selectorsData {
+		switch s := selector.(type) {
+		case map[any]any:
+			selectors = append(selectors, parseAclDataToMap(s))
+		case map[string]any:
+			selectors = append(selectors, s)
+		}
+	}
+	return selectors
+}
+
 func convertToTypesList(ctx context.Context, items []string, diags *diag.Diagnostics) (types.List, error) {
 	if len(items) > 0 {
 		list, listDiags := types.ListValueFrom(ctx, types.StringType, items)
@@ -475,6 +1385,15 @@ func convertToTypesList(ctx context.Context, items []string, diags *diag.Diagnos
 	return emptyList, nil
 }
 
+// appliedPasswordHashes returns the password hashes buildACLRules writes for
+// the model, or nil when the user has nopass.
+func appliedPasswordHashes(m *RedisAclUserResourceModel, hashedPasswords []string) []string {
+	if m.NoPass.ValueBool() {
+		return nil
+	}
+	return hashedPasswords
+}
+
 func hashPassword(password string) string {
 	hash := sha256.Sum256([]byte(password))
 	hashString := fmt.Sprintf("%x", hash)
@@ -492,27 +1411,81 @@ func buildACLRules(m *RedisAclUserResourceModel, hashedPasswords []string) []str
 		rules = append(rules, "off")
 	}
 
-	for _, hashedPassword := range hashedPasswords {
-		rules = append(rules, "#"+hashedPassword)
+	if m.NoPass.ValueBool() {
+		rules = append(rules, "nopass")
+	} else {
+		// Passwords shared by several entries are only added once.
+		seen := map[string]bool{}
+		for _, hashedPassword := range hashedPasswords {
+			if !seen[hashedPassword] {
+				seen[hashedPassword] = true
+				rules = append(rules, "#"+hashedPassword)
+			}
+		}
+	}
+
+	if !m.Rules.IsNull() && !m.Rules.IsUnknown() {
+		for _, rule := range toStringList(m.Rules) {
+			rules = append(rules, rule.ValueString())
+		}
+		return rules
 	}
 
+	if m.ResetKeys.ValueBool() {
+		rules = append(rules, "resetkeys")
+	}
+	if m.AllKeys.ValueBool() {
+		rules = append(rules, "allkeys")
+	}
+	if m.ResetChannels.ValueBool() {
+		rules = append(rules, "resetchannels")
+	}
+	if m.AllChannels.ValueBool() {
+		rules = append(rules, "allchannels")
+	}
+
+	rules = append(rules, buildPermissionRules(&RedisAclSelectorModel{
+		Commands:           m.Commands,
+		ExcludedCommands:   m.ExcludedCommands,
+		Categories:         m.Categories,
+		ExcludedCategories: m.ExcludedCategories,
+		Keys:               m.Keys,
+		ReadonlyKeys:       m.ReadonlyKeys,
+		WriteonlyKeys:      m.WriteonlyKeys,
+		Channels:           m.Channels,
+	})...)
+
+	for _, selector := range m.Selectors {
+		rules = append(rules, "("+strings.Join(buildPermissionRules(&selector), " ")+")")
+	}
+
+	return rules
+}
+
+// buildPermissionRules renders the key, channel and command rules shared by
+// the root permissions of a user and each of its selectors.
+func buildPermissionRules(s *RedisAclSelectorModel) []string {
+	rules := []string{}
+
 	appendList := func(prefix string, list []types.String) {
 		for _, v := range list {
 			rules = append(rules, prefix+v.ValueString())
 		}
 	}
-	appendList("~", toStringList(m.Keys))
-	appendList("%R~", toStringList(m.ReadonlyKeys))
-	appendList("%W~", toStringList(m.WriteonlyKeys))
-	appendList("&", toStringList(m.Channels))
+	appendList("~", toStringList(s.Keys))
+	appendList("%R~", toStringList(s.ReadonlyKeys))
+	appendList("%W~", toStringList(s.WriteonlyKeys))
+	appendList("&", toStringList(s.Channels))
 
-	categories := toStringList(m.Categories)
+	categories := toStringList(s.Categories)
 	if stringInList("all", categories) {
 		rules = append(rules, "+@all")
+		appendList("-@", toStringList(s.ExcludedCategories))
 	} else {
 		appendList("+@", categories)
-		appendList("+", toStringList(m.Commands))
-		appendList("-", toStringList(m.ExcludedCommands))
+		appendList("-@", toStringList(s.ExcludedCategories))
+		appendList("+", toStringList(s.Commands))
+		appendList("-", toStringList(s.ExcludedCommands))
 	}
 
 	return rules
@@ -527,12 +1500,8 @@ func toStringList(val types.List) []types.String {
 	return out
 }
 
-func (e *RedisAclUserResource) redisClient() *redis.Client {
-	return redis.NewClient(&redis.Options{
-		Addr:     e.providerData.Address.ValueString(),
-		Username: e.providerData.Username.ValueString(),
-		Password: e.providerData.Password.ValueString(),
-	})
+func (e *RedisAclUserResource) redisClient() (redis.UniversalClient, error) {
+	return e.providerData.redisClient()
 }
 
 func toAny[T any](in []T) []any {
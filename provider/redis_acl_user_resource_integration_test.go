@@ -8,24 +8,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func newIntegrationAclUserResource(t *testing.T) *RedisAclUserResource {
+	t.Helper()
+	providerData, err := newRedisProviderData(&RedisProviderModel{
+		Address:  types.StringValue("localhost:6379"),
+		Username: types.StringValue("testuser"),
+		Password: types.StringValue("supersecretpassword"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = providerData.Client.Close() })
+
+	req := resource.ConfigureRequest{
+		ProviderData: providerData,
+	}
+	resp := &resource.ConfigureResponse{}
+	r := &RedisAclUserResource{}
+	r.Configure(context.Background(), req, resp)
+	return r
+}
+
 func TestAclSetUser_Integration(t *testing.T) {
 	if os.Getenv("INTEGRATION") == "" {
 		t.Skip("set INTEGRATION=1 to run integration tests")
 	}
 	t.Run("creates new acl user in redis", func(t *testing.T) {
-		req := resource.ConfigureRequest{
-			ProviderData: &RedisProviderModel{
-				Address:  types.StringValue("localhost:6379"),
-				Username: types.StringValue("testuser"),
-				Password: types.StringValue("supersecretpassword"),
-			},
-		}
-		resp := &resource.ConfigureResponse{}
-		r := &RedisAclUserResource{}
-		r.Configure(context.Background(), req, resp)
+		r := newIntegrationAclUserResource(t)
 
 		categories, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"read", "write", "pubsub"})
 		commands, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"config|get"})
@@ -64,16 +76,7 @@ func TestAclGetUser_Integration(t *testing.T) {
 		t.Skip("set INTEGRATION=1 to run integration tests")
 	}
 	t.Run("retrieves existing acl user from redis", func(t *testing.T) {
-		req := resource.ConfigureRequest{
-			ProviderData: &RedisProviderModel{
-				Address:  types.StringValue("localhost:6379"),
-				Username: types.StringValue("testuser"),
-				Password: types.StringValue("supersecretpassword"),
-			},
-		}
-		resp := &resource.ConfigureResponse{}
-		r := &RedisAclUserResource{}
-		r.Configure(context.Background(), req, resp)
+		r := newIntegrationAclUserResource(t)
 
 		aclData, err := r.AclGetUser("newuser", context.Background())
 		aclMap := parseAclDataToMap(aclData)
@@ -102,16 +105,7 @@ func TestLoadAclMapIntoState_Integration(t *testing.T) {
 		t.Skip("set INTEGRATION=1 to run integration tests")
 	}
 	t.Run("loads acl map into resource state", func(t *testing.T) {
-		req := resource.ConfigureRequest{
-			ProviderData: &RedisProviderModel{
-				Address:  types.StringValue("localhost:6379"),
-				Username: types.StringValue("testuser"),
-				Password: types.StringValue("supersecretpassword"),
-			},
-		}
-		resp := &resource.ConfigureResponse{}
-		r := &RedisAclUserResource{}
-		r.Configure(context.Background(), req, resp)
+		r := newIntegrationAclUserResource(t)
 		state := &RedisAclUserResourceModel{}
 
 		aclData, err := r.AclGetUser("newuser", context.Background())
@@ -136,16 +130,7 @@ func TestAclDelUser_Integration(t *testing.T) {
 		t.Skip("set INTEGRATION=1 to run integration tests")
 	}
 	t.Run("retrieves existing acl user from redis", func(t *testing.T) {
-		req := resource.ConfigureRequest{
-			ProviderData: &RedisProviderModel{
-				Address:  types.StringValue("localhost:6379"),
-				Username: types.StringValue("testuser"),
-				Password: types.StringValue("supersecretpassword"),
-			},
-		}
-		resp := &resource.ConfigureResponse{}
-		r := &RedisAclUserResource{}
-		r.Configure(context.Background(), req, resp)
+		r := newIntegrationAclUserResource(t)
 
 		result, err := r.AclDelUser("newuser", context.Background(), false)
 
@@ -153,3 +138,16 @@ func TestAclDelUser_Integration(t *testing.T) {
 		assert.Equal(t, true, result)
 	})
 }
+
+func TestAclGetUser_Missing_Integration(t *testing.T) {
+	if os.Getenv("INTEGRATION") == "" {
+		t.Skip("set INTEGRATION=1 to run integration tests")
+	}
+	t.Run("returns redis.Nil for a missing user", func(t *testing.T) {
+		r := newIntegrationAclUserResource(t)
+
+		_, err := r.AclGetUser("missing-user", context.Background())
+
+		assert.ErrorIs(t, err, redis.Nil)
+	})
+}
@@ -2,12 +2,18 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -51,11 +57,12 @@ func TestRedisAclUserResource_Configure(t *testing.T) {
 
 	t.Run("sets provider data correctly", func(t *testing.T) {
 		r := &RedisAclUserResource{}
-		providerData := &RedisProviderModel{
+		providerData, err := newRedisProviderData(&RedisProviderModel{
 			Address:  types.StringValue("localhost:6379"),
 			Username: types.StringValue("admin"),
 			Password: types.StringValue("secret"),
-		}
+		})
+		require.NoError(t, err)
 		req := resource.ConfigureRequest{
 			ProviderData: providerData,
 		}
@@ -64,9 +71,23 @@ func TestRedisAclUserResource_Configure(t *testing.T) {
 		r.Configure(context.Background(), req, resp)
 
 		require.NotNil(t, r.providerData)
-		assert.Equal(t, "localhost:6379", r.providerData.Address.ValueString())
-		assert.Equal(t, "admin", r.providerData.Username.ValueString())
-		assert.Equal(t, "secret", r.providerData.Password.ValueString())
+		assert.Equal(t, "localhost:6379", r.providerData.Model.Address.ValueString())
+		assert.Equal(t, "admin", r.providerData.Model.Username.ValueString())
+		assert.Equal(t, "secret", r.providerData.Model.Password.ValueString())
+		assert.Same(t, providerData.Client, r.providerData.Client)
+	})
+
+	t.Run("rejects unexpected provider data", func(t *testing.T) {
+		r := &RedisAclUserResource{}
+		req := resource.ConfigureRequest{
+			ProviderData: &RedisProviderModel{},
+		}
+		resp := &resource.ConfigureResponse{}
+
+		r.Configure(context.Background(), req, resp)
+
+		assert.Nil(t, r.providerData)
+		assert.True(t, resp.Diagnostics.HasError())
 	})
 }
 
@@ -88,6 +109,7 @@ func TestRedisAclUserResource_Schema(t *testing.T) {
 		assert.Contains(t, resp.Schema.Attributes, "commands")
 		assert.Contains(t, resp.Schema.Attributes, "keys")
 		assert.Contains(t, resp.Schema.Attributes, "channels")
+		assert.Contains(t, resp.Schema.Blocks, "timeouts")
 	})
 
 	t.Run("password_wo is sensitive", func(t *testing.T) {
@@ -253,6 +275,611 @@ func TestBuildACLRules(t *testing.T) {
 		assert.Contains(t, rules, "~app:*")
 		assert.Contains(t, rules, "&notifications:*")
 	})
+
+	t.Run("builds parenthesised selector rules", func(t *testing.T) {
+		ctx := context.Background()
+		rootKeys, _ := types.ListValueFrom(ctx, types.StringType, []string{"app:*"})
+		rootCategories, _ := types.ListValueFrom(ctx, types.StringType, []string{"read"})
+		selectorKeys, _ := types.ListValueFrom(ctx, types.StringType, []string{"cache:*"})
+		selectorCommands, _ := types.ListValueFrom(ctx, types.StringType, []string{"set", "del"})
+		selectorChannels, _ := types.ListValueFrom(ctx, types.StringType, []string{"events"})
+
+		model := &RedisAclUserResourceModel{
+			Name:       types.StringValue("testuser"),
+			Enabled:    types.BoolValue(true),
+			Keys:       rootKeys,
+			Categories: rootCategories,
+			Selectors: []RedisAclSelectorModel{
+				{Keys: selectorKeys, Commands: selectorCommands},
+				{Channels: selectorChannels, Categories: types.ListNull(types.StringType)},
+			},
+		}
+
+		rules := buildACLRules(model, nil)
+
+		assert.Equal(t, []string{"reset", "on", "~app:*", "+@read", "(~cache:* +set +del)", "(&events)"}, rules)
+	})
+}
+
+func TestBuildACLRules_ExcludedCategories(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("excludes categories after all", func(t *testing.T) {
+		categories, _ := types.ListValueFrom(ctx, types.StringType, []string{"all"})
+		excludedCategories, _ := types.ListValueFrom(ctx, types.StringType, []string{"dangerous"})
+		model := &RedisAclUserResourceModel{
+			Enabled:            types.BoolValue(true),
+			Categories:         categories,
+			ExcludedCategories: excludedCategories,
+		}
+
+		rules := buildACLRules(model, nil)
+
+		assert.Equal(t, []string{"reset", "on", "+@all", "-@dangerous"}, rules)
+	})
+
+	t.Run("excludes categories before commands", func(t *testing.T) {
+		categories, _ := types.ListValueFrom(ctx, types.StringType, []string{"read", "write"})
+		excludedCategories, _ := types.ListValueFrom(ctx, types.StringType, []string{"slow"})
+		commands, _ := types.ListValueFrom(ctx, types.StringType, []string{"keys"})
+		model := &RedisAclUserResourceModel{
+			Enabled:            types.BoolValue(true),
+			Categories:         categories,
+			ExcludedCategories: excludedCategories,
+			Commands:           commands,
+		}
+
+		rules := buildACLRules(model, nil)
+
+		assert.Equal(t, []string{"reset", "on", "+@read", "+@write", "-@slow", "+keys"}, rules)
+	})
+}
+
+func TestBuildACLRules_Rules(t *testing.T) {
+	t.Run("appends raw rules verbatim after reset", func(t *testing.T) {
+		model := &RedisAclUserResourceModel{
+			Name:     types.StringValue("testuser"),
+			Enabled:  types.BoolValue(true),
+			Commands: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("get")}),
+			Rules: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("+@all"),
+				types.StringValue("-flushall"),
+				types.StringValue("+flushall|async"),
+			}),
+		}
+
+		rules := buildACLRules(model, []string{"abc"})
+
+		assert.Equal(t, []string{"reset", "on", "#abc", "+@all", "-flushall", "+flushall|async"}, rules)
+	})
+}
+
+func TestBuildACLRules_Flags(t *testing.T) {
+	t.Run("emits key and channel flags before patterns", func(t *testing.T) {
+		model := &RedisAclUserResourceModel{
+			Name:          types.StringValue("testuser"),
+			Enabled:       types.BoolValue(true),
+			AllKeys:       types.BoolValue(true),
+			AllChannels:   types.BoolValue(true),
+			ResetKeys:     types.BoolValue(true),
+			ResetChannels: types.BoolValue(true),
+			Categories:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("read")}),
+		}
+
+		rules := buildACLRules(model, []string{"abc"})
+
+		assert.Equal(t, []string{"reset", "on", "#abc", "resetkeys", "allkeys", "resetchannels", "allchannels", "+@read"}, rules)
+	})
+
+	t.Run("nopass replaces password hashes", func(t *testing.T) {
+		model := &RedisAclUserResourceModel{
+			Name:    types.StringValue("testuser"),
+			Enabled: types.BoolValue(true),
+			NoPass:  types.BoolValue(true),
+		}
+
+		rules := buildACLRules(model, []string{"abc"})
+
+		assert.Equal(t, []string{"reset", "on", "nopass"}, rules)
+	})
+
+	t.Run("merges duplicate password hashes", func(t *testing.T) {
+		model := &RedisAclUserResourceModel{
+			Name:    types.StringValue("testuser"),
+			Enabled: types.BoolValue(true),
+		}
+
+		rules := buildACLRules(model, []string{"abc", "def", "abc"})
+
+		assert.Equal(t, []string{"reset", "on", "#abc", "#def"}, rules)
+	})
+}
+
+func TestParseFlagFromAclMap(t *testing.T) {
+	aclMap := map[string]any{"flags": []any{"on", "nopass", "allkeys"}}
+
+	assert.True(t, parseFlagFromAclMap(aclMap, "nopass"))
+	assert.True(t, parseFlagFromAclMap(aclMap, "allkeys"))
+	assert.False(t, parseFlagFromAclMap(aclMap, "allchannels"))
+	assert.False(t, parseFlagFromAclMap(map[string]any{}, "nopass"))
+}
+
+func TestSplitWildcardPattern(t *testing.T) {
+	t.Run("keeps wildcard as pattern without flag", func(t *testing.T) {
+		patterns, flag := splitWildcardPattern([]string{"*"}, false, false)
+
+		assert.Equal(t, []string{"*"}, patterns)
+		assert.False(t, flag)
+	})
+
+	t.Run("attributes wildcard to reported flag", func(t *testing.T) {
+		patterns, flag := splitWildcardPattern([]string{"*", "app:*"}, true, false)
+
+		assert.Equal(t, []string{"app:*"}, patterns)
+		assert.True(t, flag)
+	})
+
+	t.Run("attributes wildcard to prior flag", func(t *testing.T) {
+		patterns, flag := splitWildcardPattern([]string{"*"}, false, true)
+
+		assert.Equal(t, []string{}, patterns)
+		assert.True(t, flag)
+	})
+
+	t.Run("clears prior flag when wildcard is gone", func(t *testing.T) {
+		patterns, flag := splitWildcardPattern([]string{"app:*"}, false, true)
+
+		assert.Equal(t, []string{"app:*"}, patterns)
+		assert.False(t, flag)
+	})
+}
+
+func TestLoadAclMapIntoState_Flags(t *testing.T) {
+	t.Run("loads nopass and wildcard flags", func(t *testing.T) {
+		ctx := context.Background()
+		diags := &diag.Diagnostics{}
+		state := &RedisAclUserResourceModel{
+			AllKeys:     types.BoolValue(true),
+			AllChannels: types.BoolValue(false),
+			ResetKeys:   types.BoolValue(true),
+		}
+
+		_ = loadAclMapIntoState(ctx, map[string]any{
+			"flags":    []any{"on", "nopass"},
+			"keys":     "~*",
+			"channels": "&*",
+		}, state, diags)
+
+		require.False(t, diags.HasError())
+		assert.True(t, state.NoPass.ValueBool())
+		assert.True(t, state.AllKeys.ValueBool())
+		assertStringList(t, []string{}, state.Keys)
+		assert.False(t, state.AllChannels.ValueBool())
+		assertStringList(t, []string{"*"}, state.Channels)
+		assert.True(t, state.ResetKeys.ValueBool())
+	})
+}
+
+func TestValidatePasswordConfig(t *testing.T) {
+	t.Run("accepts password", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{
+			PasswordWo:        types.StringValue("secret"),
+			PasswordWoVersion: types.StringValue("1"),
+		}, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("requires version with password", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{PasswordWo: types.StringValue("secret")}, &diags)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, path.Root("password_wo_version"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	})
+
+	t.Run("accepts password blocks without password_wo", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{
+			Passwords: []RedisAclPasswordModel{
+				{Name: types.StringValue("svc-a"), ValueWo: types.StringValue("a"), Version: types.StringValue("1")},
+				{Name: types.StringValue("svc-b"), ValueWo: types.StringValue("b"), Version: types.StringValue("1")},
+			},
+		}, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("rejects duplicate password names", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{
+			Passwords: []RedisAclPasswordModel{
+				{Name: types.StringValue("svc"), ValueWo: types.StringValue("a"), Version: types.StringValue("1")},
+				{Name: types.StringValue("svc"), ValueWo: types.StringValue("b"), Version: types.StringValue("1")},
+			},
+		}, &diags)
+
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Equal(t, path.Root("password").AtListIndex(1).AtName("name"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	})
+
+	t.Run("rejects password blocks with nopass", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{
+			NoPass:    types.BoolValue(true),
+			Passwords: []RedisAclPasswordModel{{Name: types.StringValue("svc"), ValueWo: types.StringValue("a"), Version: types.StringValue("1")}},
+		}, &diags)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, path.Root("password"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	})
+
+	t.Run("accepts nopass without password", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{NoPass: types.BoolValue(true)}, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("requires password without nopass", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{}, &diags)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, path.Root("password_wo"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	})
+
+	t.Run("rejects password with nopass", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{
+			PasswordWo: types.StringValue("secret"),
+			NoPass:     types.BoolValue(true),
+		}, &diags)
+
+		assert.True(t, diags.HasError())
+	})
+}
+
+func TestValidatePasswordConfig_PasswordHash(t *testing.T) {
+	hash := hashPassword("secret")
+
+	t.Run("accepts a hash", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{
+			PasswordHashWo:    types.StringValue(hash),
+			PasswordWoVersion: types.StringValue("1"),
+		}, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("rejects malformed hashes", func(t *testing.T) {
+		for _, value := range []string{"abc", hash[:63] + "g", hash + "00"} {
+			var diags diag.Diagnostics
+			validatePasswordConfig(&RedisAclUserResourceModel{
+				PasswordHashWo:    types.StringValue(value),
+				PasswordWoVersion: types.StringValue("1"),
+			}, &diags)
+
+			require.True(t, diags.HasError(), value)
+			assert.Equal(t, path.Root("password_hash_wo"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+		}
+	})
+
+	t.Run("rejects hash with password", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{
+			PasswordWo:        types.StringValue("secret"),
+			PasswordHashWo:    types.StringValue(hash),
+			PasswordWoVersion: types.StringValue("1"),
+		}, &diags)
+
+		require.Equal(t, 1, diags.ErrorsCount())
+		assert.Equal(t, path.Root("password_hash_wo"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	})
+
+	t.Run("requires version with hash", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordConfig(&RedisAclUserResourceModel{PasswordHashWo: types.StringValue(hash)}, &diags)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, path.Root("password_wo_version"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	})
+}
+
+func TestConfigPasswordHash(t *testing.T) {
+	t.Run("hashes password_wo", func(t *testing.T) {
+		hash, ok := configPasswordHash(&RedisAclUserResourceModel{PasswordWo: types.StringValue("secret")})
+
+		assert.True(t, ok)
+		assert.Equal(t, hashPassword("secret"), hash)
+	})
+
+	t.Run("passes password_hash_wo through in lower case", func(t *testing.T) {
+		hash, ok := configPasswordHash(&RedisAclUserResourceModel{PasswordHashWo: types.StringValue(strings.ToUpper(hashPassword("secret")))})
+
+		assert.True(t, ok)
+		assert.Equal(t, hashPassword("secret"), hash)
+	})
+
+	t.Run("reports missing password", func(t *testing.T) {
+		_, ok := configPasswordHash(&RedisAclUserResourceModel{})
+
+		assert.False(t, ok)
+	})
+}
+
+func TestValidatePasswordRotationConfig(t *testing.T) {
+	t.Run("ignores missing block", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordRotationConfig(nil, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("accepts applies and duration", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordRotationConfig(&RedisAclPasswordRotationModel{
+			KeepPreviousApplies: types.Int64Value(2),
+			KeepPreviousFor:     types.StringValue("24h"),
+		}, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("requires a grace window", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordRotationConfig(&RedisAclPasswordRotationModel{}, &diags)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, path.Root("password_rotation"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validatePasswordRotationConfig(&RedisAclPasswordRotationModel{
+			KeepPreviousApplies: types.Int64Value(0),
+			KeepPreviousFor:     types.StringValue("soon"),
+		}, &diags)
+
+		require.Equal(t, 2, diags.ErrorsCount())
+		assert.Equal(t, path.Root("password_rotation").AtName("keep_previous_applies"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+		assert.Equal(t, path.Root("password_rotation").AtName("keep_previous_for"), diags.Errors()[1].(diag.DiagnosticWithPath).Path())
+	})
+}
+
+func TestValidateRulesConfig(t *testing.T) {
+	rulesList := func(rules ...string) types.List {
+		values := make([]attr.Value, len(rules))
+		for i, rule := range rules {
+			values[i] = types.StringValue(rule)
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	t.Run("accepts rules alone", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validateRulesConfig(&RedisAclUserResourceModel{
+			Commands: types.ListNull(types.StringType),
+			Rules:    rulesList("+@all", "-flushall", "(~cache:* +get)"),
+		}, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("ignores configs without rules", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validateRulesConfig(&RedisAclUserResourceModel{
+			Commands: rulesList("get"),
+			Rules:    types.ListNull(types.StringType),
+		}, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("rejects structured attributes and selectors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validateRulesConfig(&RedisAclUserResourceModel{
+			Commands:           rulesList("get"),
+			ExcludedCommands:   types.ListNull(types.StringType),
+			Categories:         types.ListNull(types.StringType),
+			ExcludedCategories: types.ListNull(types.StringType),
+			Keys:               rulesList("app:*"),
+			ReadonlyKeys:       types.ListNull(types.StringType),
+			WriteonlyKeys:      types.ListNull(types.StringType),
+			Channels:           types.ListNull(types.StringType),
+			Selectors:          []RedisAclSelectorModel{{}},
+			Rules:              rulesList("+@all"),
+		}, &diags)
+
+		require.Equal(t, 3, diags.ErrorsCount())
+		paths := []path.Path{}
+		for _, d := range diags.Errors() {
+			paths = append(paths, d.(diag.DiagnosticWithPath).Path())
+		}
+		assert.Equal(t, []path.Path{path.Root("commands"), path.Root("keys"), path.Root("selector")}, paths)
+	})
+
+	t.Run("rejects password and state rules", func(t *testing.T) {
+		var diags diag.Diagnostics
+		validateRulesConfig(&RedisAclUserResourceModel{
+			Rules: rulesList("+@all", ">secret", "nopass"),
+		}, &diags)
+
+		require.Equal(t, 2, diags.ErrorsCount())
+		assert.Equal(t, path.Root("rules").AtListIndex(1), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+		assert.Equal(t, path.Root("rules").AtListIndex(2), diags.Errors()[1].(diag.DiagnosticWithPath).Path())
+	})
+}
+
+func TestResolveUnknownLists(t *testing.T) {
+	t.Run("fills only unknown lists", func(t *testing.T) {
+		plan := &RedisAclUserResourceModel{
+			Commands: types.ListUnknown(types.StringType),
+			Keys:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app:*")}),
+		}
+		loaded := &RedisAclUserResourceModel{
+			Commands: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("get")}),
+			Keys:     types.ListValueMust(types.StringType, []attr.Value{}),
+		}
+
+		resolveUnknownLists(plan, loaded)
+
+		assertStringList(t, []string{"get"}, plan.Commands)
+		assertStringList(t, []string{"app:*"}, plan.Keys)
+	})
+}
+
+func TestParseExcludedCategoriesFromAclMap(t *testing.T) {
+	t.Run("parses excluded categories", func(t *testing.T) {
+		aclMap := map[string]any{"commands": "+@all -@dangerous -@slow"}
+
+		assert.Equal(t, []string{"dangerous", "slow"}, parseExcludedCategoriesFromAclMap(aclMap))
+	})
+
+	t.Run("skips the implicit -@all baseline", func(t *testing.T) {
+		aclMap := map[string]any{"commands": "-@all +@read -keys"}
+
+		assert.Empty(t, parseExcludedCategoriesFromAclMap(aclMap))
+	})
+
+	t.Run("handles missing commands", func(t *testing.T) {
+		result := parseExcludedCategoriesFromAclMap(map[string]any{})
+
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
+
+func TestLoadAclMapIntoState_ExcludedCategories(t *testing.T) {
+	t.Run("round-trips +@all -@dangerous", func(t *testing.T) {
+		ctx := context.Background()
+		diags := &diag.Diagnostics{}
+		state := &RedisAclUserResourceModel{Name: types.StringValue("testuser")}
+
+		err := loadAclMapIntoState(ctx, map[string]any{
+			"flags":    []any{"on"},
+			"commands": "+@all -@dangerous",
+		}, state, diags)
+
+		require.NoError(t, err)
+		assertStringList(t, []string{"all"}, state.Categories)
+		assertStringList(t, []string{"dangerous"}, state.ExcludedCategories)
+		assert.Equal(t, []string{"reset", "on", "+@all", "-@dangerous"}, buildACLRules(state, nil))
+	})
+}
+
+func TestLoadAclMapIntoState_Selectors(t *testing.T) {
+	aclMap := map[string]any{
+		"flags":    []any{"on"},
+		"commands": "-@all +@read",
+		"keys":     "~app:*",
+		"channels": "",
+		"selectors": []any{
+			map[any]any{
+				"commands": "-@all +set",
+				"keys":     "~cache:*",
+				"channels": "",
+			},
+		},
+	}
+
+	t.Run("loads selectors with omitted lists as null", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+		state := &RedisAclUserResourceModel{Name: types.StringValue("testuser")}
+
+		err := loadAclMapIntoState(context.Background(), aclMap, state, diags)
+
+		require.NoError(t, err)
+		require.Len(t, state.Selectors, 1)
+		assertStringList(t, []string{"set"}, state.Selectors[0].Commands)
+		assertStringList(t, []string{"cache:*"}, state.Selectors[0].Keys)
+		assert.True(t, state.Selectors[0].Channels.IsNull())
+		assert.True(t, state.Selectors[0].Categories.IsNull())
+	})
+
+	t.Run("keeps explicit empty lists from prior state", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+		emptyChannels, _ := types.ListValueFrom(context.Background(), types.StringType, []string{})
+		state := &RedisAclUserResourceModel{
+			Name: types.StringValue("testuser"),
+			Selectors: []RedisAclSelectorModel{
+				{Channels: emptyChannels, Categories: types.ListNull(types.StringType)},
+			},
+		}
+
+		err := loadAclMapIntoState(context.Background(), aclMap, state, diags)
+
+		require.NoError(t, err)
+		require.Len(t, state.Selectors, 1)
+		assert.False(t, state.Selectors[0].Channels.IsNull())
+		assert.Empty(t, state.Selectors[0].Channels.Elements())
+		assert.True(t, state.Selectors[0].Categories.IsNull())
+	})
+
+	t.Run("round-trips through buildACLRules", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+		state := &RedisAclUserResourceModel{Name: types.StringValue("testuser")}
+
+		_ = loadAclMapIntoState(context.Background(), aclMap, state, diags)
+
+		assert.Equal(t, []string{"reset", "on", "~app:*", "+@read", "(~cache:* +set)"}, buildACLRules(state, nil))
+	})
+
+	t.Run("loads empty selector list without selectors", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+		state := &RedisAclUserResourceModel{Name: types.StringValue("testuser")}
+
+		_ = loadAclMapIntoState(context.Background(), map[string]any{"flags": []any{"on"}}, state, diags)
+
+		assert.NotNil(t, state.Selectors)
+		assert.Empty(t, state.Selectors)
+	})
+}
+
+func TestRedisAclUserResource_SchemaMatchesModel(t *testing.T) {
+	t.Run("state can hold a fully loaded model", func(t *testing.T) {
+		ctx := context.Background()
+		r := &RedisAclUserResource{}
+		resp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+		diags := &diag.Diagnostics{}
+		model := &RedisAclUserResourceModel{
+			Name:              types.StringValue("testuser"),
+			PasswordWo:        types.StringNull(),
+			PasswordWoVersion: types.StringValue("1"),
+			Rules:             types.ListNull(types.StringType),
+			AclSave:           types.BoolValue(true),
+		}
+		_ = loadAclMapIntoState(ctx, map[string]any{
+			"flags":     []any{"on"},
+			"commands":  "+@read",
+			"keys":      "~app:*",
+			"selectors": []any{map[any]any{"commands": "+set", "keys": "~cache:*", "channels": ""}},
+		}, model, diags)
+		require.False(t, diags.HasError())
+
+		state := tfsdk.State{
+			Schema: resp.Schema,
+			Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+		}
+		setDiags := state.Set(ctx, model)
+
+		assert.False(t, setDiags.HasError(), setDiags)
+	})
+
+	t.Run("schema is a valid implementation", func(t *testing.T) {
+		ctx := context.Background()
+		r := &RedisAclUserResource{}
+		resp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+		diags := resp.Schema.ValidateImplementation(ctx)
+
+		assert.False(t, diags.HasError(), diags)
+	})
 }
 
 func TestToStringList(t *testing.T) {
@@ -335,18 +962,129 @@ func TestToAny(t *testing.T) {
 }
 
 func TestRedisAclUserResource_redisClient(t *testing.T) {
-	t.Run("creates redis client with correct configuration", func(t *testing.T) {
-		r := &RedisAclUserResource{
-			providerData: &RedisProviderModel{
-				Address:  types.StringValue("localhost:6379"),
-				Username: types.StringValue("admin"),
-				Password: types.StringValue("secret"),
-			},
+	t.Run("returns the shared provider client", func(t *testing.T) {
+		providerData, err := newRedisProviderData(&RedisProviderModel{
+			Address:  types.StringValue("localhost:6379"),
+			Username: types.StringValue("admin"),
+			Password: types.StringValue("secret"),
+		})
+		require.NoError(t, err)
+		r := &RedisAclUserResource{providerData: providerData}
+
+		first, err := r.redisClient()
+		require.NoError(t, err)
+		second, err := r.redisClient()
+		require.NoError(t, err)
+
+		assert.Same(t, providerData.Client, first)
+		assert.Same(t, first, second)
+	})
+
+	t.Run("fails when provider is not configured", func(t *testing.T) {
+		r := &RedisAclUserResource{}
+
+		client, err := r.redisClient()
+
+		assert.Error(t, err)
+		assert.Nil(t, client)
+	})
+}
+
+func TestRedisAclUserResource_Read(t *testing.T) {
+	t.Run("keeps prior state when provider is not configured", func(t *testing.T) {
+		ctx := context.Background()
+		r := &RedisAclUserResource{}
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+		state := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		model := &RedisAclUserResourceModel{
+			Name:              types.StringValue("testuser"),
+			PasswordWo:        types.StringNull(),
+			PasswordWoVersion: types.StringValue("1"),
+			Rules:             types.ListNull(types.StringType),
+			AclSave:           types.BoolValue(true),
+		}
+		diags := diag.Diagnostics{}
+		_ = loadAclMapIntoState(ctx, map[string]any{"flags": []any{"on"}, "commands": "+@read", "keys": "~app:*"}, model, &diags)
+		diags.Append(state.Set(ctx, model)...)
+		require.False(t, diags.HasError(), diags)
+
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, resp.State.Raw.Equal(state.Raw))
+	})
+}
+
+func TestReadPasswordDrift(t *testing.T) {
+	ctx := context.Background()
+	hashA, hashB, hashC := hashPassword("a"), hashPassword("b"), hashPassword("c")
+	blocks := func() []RedisAclPasswordModel {
+		return []RedisAclPasswordModel{
+			{Name: types.StringValue("api"), Version: types.StringValue("1")},
+			{Name: types.StringValue("worker"), Version: types.StringValue("1")},
 		}
+	}
+	blockOnly := func(t *testing.T) fakePrivateState {
+		private := fakePrivateState{}
+		require.False(t, setPrivatePasswords(ctx, private, []string{}).HasError())
+		require.False(t, setPrivatePasswordEntries(ctx, private, blocks(), []string{hashA, hashB}).HasError())
+		return private
+	}
+
+	t.Run("keeps versions without drift", func(t *testing.T) {
+		state := &RedisAclUserResourceModel{Name: types.StringValue("app"), PasswordWoVersion: types.StringNull(), Passwords: blocks()}
+		diags := diag.Diagnostics{}
+
+		readPasswordDrift(ctx, state, []string{hashB, hashA}, blockOnly(t), &diags)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, blocks(), state.Passwords)
+	})
+
+	t.Run("nulls the version of a removed block password", func(t *testing.T) {
+		state := &RedisAclUserResourceModel{Name: types.StringValue("app"), PasswordWoVersion: types.StringNull(), Passwords: blocks()}
+		diags := diag.Diagnostics{}
+
+		readPasswordDrift(ctx, state, []string{hashA}, blockOnly(t), &diags)
+
+		require.Equal(t, 1, diags.WarningsCount())
+		assert.Contains(t, diags[0].Detail(), "'worker'")
+		assert.NotContains(t, diags[0].Detail(), "password_wo")
+		assert.Equal(t, types.StringValue("1"), state.Passwords[0].Version)
+		assert.True(t, state.Passwords[1].Version.IsNull())
+	})
 
-		client := r.redisClient()
+	t.Run("nulls block versions when unmanaged passwords are added", func(t *testing.T) {
+		state := &RedisAclUserResourceModel{Name: types.StringValue("app"), PasswordWoVersion: types.StringNull(), Passwords: blocks()}
+		diags := diag.Diagnostics{}
 
-		require.NotNil(t, client)
+		readPasswordDrift(ctx, state, []string{hashA, hashB, hashC}, blockOnly(t), &diags)
+
+		require.Equal(t, 1, diags.WarningsCount())
+		assert.NotContains(t, diags[0].Detail(), "password_wo")
+		assert.True(t, state.Passwords[0].Version.IsNull())
+		assert.True(t, state.Passwords[1].Version.IsNull())
+	})
+
+	t.Run("attributes password_wo drift to password_wo_version only", func(t *testing.T) {
+		private := fakePrivateState{}
+		require.False(t, setPrivatePasswords(ctx, private, []string{hashC}).HasError())
+		require.False(t, setPrivatePasswordEntries(ctx, private, blocks(), []string{hashA, hashB}).HasError())
+		state := &RedisAclUserResourceModel{Name: types.StringValue("app"), PasswordWoVersion: types.StringValue("1"), Passwords: blocks()}
+		diags := diag.Diagnostics{}
+
+		readPasswordDrift(ctx, state, []string{hashA, hashB}, private, &diags)
+
+		require.Equal(t, 1, diags.WarningsCount())
+		assert.Contains(t, diags[0].Detail(), "password_wo")
+		assert.True(t, state.PasswordWoVersion.IsNull())
+		assert.Equal(t, blocks(), state.Passwords)
 	})
 }
 
@@ -572,6 +1310,30 @@ func TestParseChannelsFromAclMap(t *testing.T) {
 	})
 }
 
+func TestParseSelectorsFromAclMap(t *testing.T) {
+	t.Run("parses selectors", func(t *testing.T) {
+		aclMap := map[string]any{
+			"selectors": []any{
+				map[any]any{"commands": "-@all +get", "keys": "~a:*", "channels": ""},
+				map[any]any{"commands": "-@all +set", "keys": "~b:*", "channels": "&c"},
+			},
+		}
+
+		result := parseSelectorsFromAclMap(aclMap)
+
+		require.Len(t, result, 2)
+		assert.Equal(t, "-@all +get", result[0]["commands"])
+		assert.Equal(t, "&c", result[1]["channels"])
+	})
+
+	t.Run("returns empty slice without selectors", func(t *testing.T) {
+		result := parseSelectorsFromAclMap(map[string]any{})
+
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
+
 func TestConvertToTypesList(t *testing.T) {
 	t.Run("converts string slice to types.List", func(t *testing.T) {
 		ctx := context.Background()
@@ -678,6 +1440,67 @@ func TestLoadAclMapIntoState(t *testing.T) {
 	})
 }
 
+func TestSelectNodeAclMap(t *testing.T) {
+	ctx := context.Background()
+	readOnly := map[any]any{
+		"flags":    []any{"on"},
+		"commands": "+@read",
+		"keys":     "~app:*",
+		"channels": "",
+	}
+	readWrite := map[any]any{
+		"flags":    []any{"on"},
+		"commands": "+@read +@write",
+		"keys":     "~app:*",
+		"channels": "",
+	}
+	categories, _ := types.ListValueFrom(ctx, types.StringType, []string{"read"})
+	keys, _ := types.ListValueFrom(ctx, types.StringType, []string{"app:*"})
+	state := RedisAclUserResourceModel{
+		Name:       types.StringValue("testuser"),
+		Enabled:    types.BoolValue(true),
+		Categories: categories,
+		Keys:       keys,
+	}
+
+	t.Run("returns nil when user is missing everywhere", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+
+		result := selectNodeAclMap(ctx, []map[any]any{nil, nil}, state, diags)
+
+		assert.Nil(t, result)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("returns data when nodes agree", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+
+		result := selectNodeAclMap(ctx, []map[any]any{readOnly, readOnly}, state, diags)
+
+		assert.Equal(t, "+@read", result["commands"])
+		assert.Empty(t, diags.Warnings())
+	})
+
+	t.Run("returns the diverging node when nodes disagree", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+
+		result := selectNodeAclMap(ctx, []map[any]any{readOnly, readWrite, readOnly}, state, diags)
+
+		assert.Equal(t, "+@read +@write", result["commands"])
+		assert.Len(t, diags.Warnings(), 1)
+	})
+
+	t.Run("reports drift when user is missing on a node", func(t *testing.T) {
+		diags := &diag.Diagnostics{}
+
+		result := selectNodeAclMap(ctx, []map[any]any{readOnly, nil}, state, diags)
+
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+		assert.Len(t, diags.Warnings(), 1)
+	})
+}
+
 func TestStringInList(t *testing.T) {
 	t.Run("finds string in list", func(t *testing.T) {
 		list := []types.String{
@@ -827,3 +1650,16 @@ func BenchmarkLoadAclMapIntoState(b *testing.B) {
 		loadAclMapIntoState(ctx, aclMap, state, diags)
 	}
 }
+
+func TestRedisAclUserResource_AclGetUserErrors(t *testing.T) {
+	t.Run("connection errors are not reported as a missing user", func(t *testing.T) {
+		client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+		defer client.Close()
+		r := &RedisAclUserResource{providerData: &RedisProviderData{Client: client}}
+
+		_, err := r.AclGetUser("app", context.Background())
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, redis.Nil)
+	})
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ datasource.DataSource = &RedisAclUsersDataSource{}
+
+func NewRedisAclUsersDataSource() datasource.DataSource {
+	return &RedisAclUsersDataSource{}
+}
+
+type RedisAclUsersDataSource struct {
+	providerData *RedisProviderData
+}
+
+type RedisAclUsersDataSourceModel struct {
+	NameRegex    types.String                  `tfsdk:"name_regex"`
+	NamePrefix   types.String                  `tfsdk:"name_prefix"`
+	IncludeRules types.Bool                    `tfsdk:"include_rules"`
+	Names        types.List                    `tfsdk:"names"`
+	Users        []RedisAclUserDataSourceModel `tfsdk:"users"`
+}
+
+func (d *RedisAclUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*RedisProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *RedisProviderData, got %T", req.ProviderData))
+		return
+	}
+	d.providerData = providerData
+}
+
+func (d *RedisAclUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_users"
+}
+
+func (d *RedisAclUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	userAttributes := aclUserComputedAttributes()
+	userAttributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the ACL user.",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Lists the ACL users defined on the Redis server.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return users whose name matches this regular expression.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return users whose name starts with this prefix.",
+			},
+			"include_rules": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to read the rules of every returned user into users. Defaults to false.",
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted names of the matching ACL users.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Parsed rules of the matching ACL users. Empty unless include_rules is true.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *RedisAclUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RedisAclUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		if nameRegex, err = regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	client, err := d.providerData.redisClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list ACL users", err.Error())
+		return
+	}
+
+	usernames, err := aclUsers(ctx, client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list ACL users", err.Error())
+		return
+	}
+	usernames = filterUsernames(usernames, data.NamePrefix.ValueString(), nameRegex)
+
+	data.Names, _ = convertToTypesList(ctx, usernames, &resp.Diagnostics)
+	data.Users = []RedisAclUserDataSourceModel{}
+
+	if data.IncludeRules.ValueBool() {
+		for _, username := range usernames {
+			aclData, err := aclGetUser(ctx, client, username)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to read ACL user", fmt.Sprintf("ACL user '%s': %s", username, err))
+				return
+			}
+			user := RedisAclUserDataSourceModel{Name: types.StringValue(username)}
+			loadAclMapIntoDataSourceModel(ctx, parseAclDataToMap(aclData), &user, &resp.Diagnostics)
+			data.Users = append(data.Users, user)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// aclUsers returns the sorted names from ACL USERS. In cluster mode the
+// names of every node are merged.
+func aclUsers(ctx context.Context, client redis.UniversalClient) ([]string, error) {
+	var mu sync.Mutex
+	seen := map[string]bool{}
+	err := forEachNode(ctx, client, func(ctx context.Context, node redis.UniversalClient) error {
+		names, err := node.Do(ctx, "ACL", "USERS").StringSlice()
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, name := range names {
+			seen[name] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usernames := make([]string, 0, len(seen))
+	for name := range seen {
+		usernames = append(usernames, name)
+	}
+	slices.Sort(usernames)
+	return usernames, nil
+}
+
+func filterUsernames(usernames []string, prefix string, nameRegex *regexp.Regexp) []string {
+	filtered := []string{}
+	for _, name := range usernames {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		filtered = append(filtered, name)
+	}
+	return filtered
+}
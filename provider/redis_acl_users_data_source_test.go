@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedisAclUsersDataSource(t *testing.T) {
+	t.Run("creates new data source instance", func(t *testing.T) {
+		dataSource := NewRedisAclUsersDataSource()
+
+		assert.NotNil(t, dataSource)
+		assert.IsType(t, &RedisAclUsersDataSource{}, dataSource)
+	})
+}
+
+func TestRedisAclUsersDataSource_Metadata(t *testing.T) {
+	t.Run("sets correct type name", func(t *testing.T) {
+		d := &RedisAclUsersDataSource{}
+		resp := &datasource.MetadataResponse{}
+
+		d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "redis"}, resp)
+
+		assert.Equal(t, "redis_acl_users", resp.TypeName)
+	})
+}
+
+func TestRedisAclUsersDataSource_Schema(t *testing.T) {
+	t.Run("model matches schema", func(t *testing.T) {
+		ctx := context.Background()
+		d := &RedisAclUsersDataSource{}
+		resp := &datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+		diags := &diag.Diagnostics{}
+		user := RedisAclUserDataSourceModel{Name: types.StringValue("app")}
+		loadAclMapIntoDataSourceModel(ctx, map[string]any{
+			"flags":     []any{"on"},
+			"commands":  "+@read",
+			"selectors": []any{map[any]any{"commands": "+get", "keys": "~k", "channels": ""}},
+		}, &user, diags)
+		names, _ := convertToTypesList(ctx, []string{"app"}, diags)
+		require.False(t, diags.HasError())
+
+		state := tfsdk.State{
+			Schema: resp.Schema,
+			Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+		}
+		setDiags := state.Set(ctx, &RedisAclUsersDataSourceModel{
+			NameRegex:    types.StringNull(),
+			NamePrefix:   types.StringNull(),
+			IncludeRules: types.BoolValue(true),
+			Names:        names,
+			Users:        []RedisAclUserDataSourceModel{user},
+		})
+
+		assert.False(t, setDiags.HasError(), setDiags)
+	})
+}
+
+func TestFilterUsernames(t *testing.T) {
+	usernames := []string{"app-reader", "app-writer", "default", "svc-cache"}
+
+	t.Run("returns all names without filters", func(t *testing.T) {
+		assert.Equal(t, usernames, filterUsernames(usernames, "", nil))
+	})
+
+	t.Run("filters by prefix", func(t *testing.T) {
+		assert.Equal(t, []string{"app-reader", "app-writer"}, filterUsernames(usernames, "app-", nil))
+	})
+
+	t.Run("filters by regex", func(t *testing.T) {
+		assert.Equal(t, []string{"app-writer", "svc-cache"}, filterUsernames(usernames, "", regexp.MustCompile(`writer|cache`)))
+	})
+
+	t.Run("combines prefix and regex", func(t *testing.T) {
+		assert.Equal(t, []string{"app-reader"}, filterUsernames(usernames, "app-", regexp.MustCompile(`reader$`)))
+	})
+
+	t.Run("returns empty slice when nothing matches", func(t *testing.T) {
+		result := filterUsernames(usernames, "none", nil)
+
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
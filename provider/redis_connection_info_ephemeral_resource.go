@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &RedisConnectionInfoEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &RedisConnectionInfoEphemeralResource{}
+
+func NewRedisConnectionInfoEphemeralResource() ephemeral.EphemeralResource {
+	return &RedisConnectionInfoEphemeralResource{}
+}
+
+type RedisConnectionInfoEphemeralResource struct {
+	providerData *RedisProviderData
+}
+
+type RedisConnectionInfoEphemeralResourceModel struct {
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	Mode                  types.String `tfsdk:"mode"`
+	Host                  types.String `tfsdk:"host"`
+	Port                  types.Int64  `tfsdk:"port"`
+	Addresses             types.List   `tfsdk:"addresses"`
+	SocketPath            types.String `tfsdk:"socket_path"`
+	Database              types.Int64  `tfsdk:"database"`
+	SentinelMasterName    types.String `tfsdk:"sentinel_master_name"`
+	TLS                   types.Bool   `tfsdk:"tls"`
+	TLSServerName         types.String `tfsdk:"tls_server_name"`
+	TLSInsecureSkipVerify types.Bool   `tfsdk:"tls_insecure_skip_verify"`
+	URL                   types.String `tfsdk:"url"`
+}
+
+// connectionInfo describes how clients reach the server the provider is
+// configured for, resolved the same way newRedisClient resolves it.
+type connectionInfo struct {
+	mode               string
+	network            string
+	addresses          []string
+	database           int
+	username           string
+	password           string
+	sentinelMasterName string
+	tls                bool
+	tlsServerName      string
+	insecureSkipVerify bool
+}
+
+const defaultRedisPort = 6379
+
+func (r *RedisConnectionInfoEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*RedisProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *RedisProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+func (r *RedisConnectionInfoEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_info"
+}
+
+func (r *RedisConnectionInfoEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assembles connection details and a connection URL for an ACL user from the provider configuration, without writing them to state.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ACL user to connect as. Defaults to the provider username.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password of the ACL user. Defaults to the provider password when username is not set.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "Deployment the provider connects to: 'standalone', 'cluster' or 'sentinel'.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Host of the first address. Null for unix sockets.",
+			},
+			"port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Port of the first address. Null for unix sockets.",
+			},
+			"addresses": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "All host:port addresses: the server, the cluster seed nodes or the sentinels.",
+			},
+			"socket_path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unix socket path, when connecting through a unix socket.",
+			},
+			"database": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Database number.",
+			},
+			"sentinel_master_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the master monitored by the sentinels, in sentinel mode.",
+			},
+			"tls": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether connections use TLS.",
+			},
+			"tls_server_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Server name used to verify the server certificate, when set.",
+			},
+			"tls_insecure_skip_verify": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether server certificate verification is disabled.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "redis://, rediss:// or unix:// URL including the credentials. In cluster mode it points at the first seed node; null in sentinel mode.",
+			},
+		},
+	}
+}
+
+func (r *RedisConnectionInfoEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data RedisConnectionInfoEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.providerData == nil || r.providerData.Model == nil {
+		resp.Diagnostics.AddError("Failed to build connection info", "provider is not configured")
+		return
+	}
+
+	info, err := connectionInfoFromModel(r.providerData.Model)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build connection info", err.Error())
+		return
+	}
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
+		info.username = data.Username.ValueString()
+		info.password = data.Password.ValueString()
+	} else if !data.Password.IsNull() && !data.Password.IsUnknown() {
+		info.password = data.Password.ValueString()
+	}
+
+	info.loadIntoModel(ctx, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func connectionInfoFromModel(m *RedisProviderModel) (*connectionInfo, error) {
+	info := &connectionInfo{
+		mode:     "standalone",
+		network:  "tcp",
+		database: int(m.Database.ValueInt64()),
+		username: m.Username.ValueString(),
+		password: m.Password.ValueString(),
+	}
+
+	tlsConfig, err := buildTLSConfig(m.TLS)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case m.Sentinel != nil:
+		info.mode = "sentinel"
+		info.sentinelMasterName = m.Sentinel.MasterName.ValueString()
+		for _, addr := range toStringList(m.Sentinel.Addresses) {
+			info.addresses = append(info.addresses, addr.ValueString())
+		}
+	case m.Cluster != nil:
+		info.mode = "cluster"
+		for _, addr := range toStringList(m.Cluster.Addresses) {
+			info.addresses = append(info.addresses, addr.ValueString())
+		}
+		if len(info.addresses) == 0 && m.Address.ValueString() != "" {
+			info.addresses = []string{m.Address.ValueString()}
+		}
+	default:
+		options, err := resolveStandaloneOptions(m)
+		if err != nil {
+			return nil, err
+		}
+		if options.Network != "" {
+			info.network = options.Network
+		}
+		info.addresses = []string{options.Addr}
+		info.database = options.DB
+		info.username = options.Username
+		info.password = options.Password
+		tlsConfig = options.TLSConfig
+	}
+
+	if tlsConfig != nil {
+		info.tls = true
+		info.tlsServerName = tlsConfig.ServerName
+		info.insecureSkipVerify = tlsConfig.InsecureSkipVerify
+	}
+	return info, nil
+}
+
+// url renders the connection as a URL accepted by redis.ParseURL and most
+// Redis clients. Sentinel deployments have no single URL.
+func (c *connectionInfo) url() (string, bool) {
+	if c.mode == "sentinel" || len(c.addresses) == 0 {
+		return "", false
+	}
+
+	u := &url.URL{Scheme: "redis", Host: c.addresses[0]}
+	if c.tls {
+		u.Scheme = "rediss"
+	}
+	if c.network == "unix" {
+		u = &url.URL{Scheme: "unix", Path: c.addresses[0]}
+	}
+	if c.username != "" || c.password != "" {
+		if c.password != "" {
+			u.User = url.UserPassword(c.username, c.password)
+		} else {
+			u.User = url.User(c.username)
+		}
+	}
+	if c.database != 0 {
+		if c.network == "unix" {
+			u.RawQuery = url.Values{"db": {strconv.Itoa(c.database)}}.Encode()
+		} else {
+			u.Path = "/" + strconv.Itoa(c.database)
+		}
+	}
+	return u.String(), true
+}
+
+// hostPort splits the first address, defaulting the port when it has none.
+func (c *connectionInfo) hostPort() (string, int, bool) {
+	if c.network == "unix" || len(c.addresses) == 0 {
+		return "", 0, false
+	}
+	host, portStr, err := net.SplitHostPort(c.addresses[0])
+	if err != nil {
+		return c.addresses[0], defaultRedisPort, true
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, defaultRedisPort, true
+	}
+	return host, port, true
+}
+
+func (c *connectionInfo) loadIntoModel(ctx context.Context, data *RedisConnectionInfoEphemeralResourceModel, diags *diag.Diagnostics) {
+	data.Mode = types.StringValue(c.mode)
+	data.Username = types.StringValue(c.username)
+	data.Password = types.StringValue(c.password)
+	data.Database = types.Int64Value(int64(c.database))
+	data.TLS = types.BoolValue(c.tls)
+	data.TLSServerName = types.StringValue(c.tlsServerName)
+	data.TLSInsecureSkipVerify = types.BoolValue(c.insecureSkipVerify)
+
+	data.Host, data.Port = types.StringNull(), types.Int64Null()
+	if host, port, ok := c.hostPort(); ok {
+		data.Host, data.Port = types.StringValue(host), types.Int64Value(int64(port))
+	}
+
+	data.SocketPath = types.StringNull()
+	addresses := c.addresses
+	if c.network == "unix" {
+		data.SocketPath = types.StringValue(c.addresses[0])
+		addresses = []string{}
+	}
+	data.Addresses, _ = convertToTypesList(ctx, addresses, diags)
+
+	data.SentinelMasterName = types.StringNull()
+	if c.mode == "sentinel" {
+		data.SentinelMasterName = types.StringValue(c.sentinelMasterName)
+	}
+
+	data.URL = types.StringNull()
+	if u, ok := c.url(); ok {
+		data.URL = types.StringValue(u)
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &RedisPasswordEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &RedisPasswordEphemeralResource{}
+
+func NewRedisPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &RedisPasswordEphemeralResource{}
+}
+
+type RedisPasswordEphemeralResource struct{}
+
+type RedisPasswordEphemeralResourceModel struct {
+	Length         types.Int64   `tfsdk:"length"`
+	Charset        types.String  `tfsdk:"charset"`
+	Characters     types.String  `tfsdk:"characters"`
+	MinEntropyBits types.Int64   `tfsdk:"min_entropy_bits"`
+	Result         types.String  `tfsdk:"result"`
+	Hash           types.String  `tfsdk:"hash"`
+	EntropyBits    types.Float64 `tfsdk:"entropy_bits"`
+}
+
+const (
+	defaultPasswordLength  = 32
+	maxPasswordLength      = 1024
+	maxPasswordEntropyBits = 4096
+)
+
+// passwordCharsets are the named character sets accepted by charset. Redis
+// accepts any byte in a password, but these avoid quoting issues in URLs and
+// configuration files.
+var passwordCharsets = map[string]string{
+	"alphanumeric": "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+	"hex":          "0123456789abcdef",
+	"base64url":    "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_",
+	"printable":    "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#$%&()*+,-./:;<=>?@[]^_{|}~",
+}
+
+func (r *RedisPasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password"
+}
+
+func (r *RedisPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a random password that is never persisted, for use in write-only attributes such as redis_acl_user.password_wo.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Number of characters, at most %d. Defaults to %d, raised if needed to reach min_entropy_bits.", maxPasswordLength, defaultPasswordLength),
+			},
+			"charset": schema.StringAttribute{
+				Optional:    true,
+				Description: "Named character set: 'alphanumeric' (default), 'hex', 'base64url' or 'printable'. Conflicts with characters.",
+			},
+			"characters": schema.StringAttribute{
+				Optional:    true,
+				Description: "Custom set of characters to draw from. Conflicts with charset.",
+			},
+			"min_entropy_bits": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Minimum entropy of the password in bits, at most %d. The length is raised until it is reached.", maxPasswordEntropyBits),
+			},
+			"result": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated password.",
+			},
+			"hash": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "SHA256 hash of the password, as accepted by redis_acl_user.password_hash_wo.",
+			},
+			"entropy_bits": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Entropy of the generated password in bits.",
+			},
+		},
+	}
+}
+
+func (r *RedisPasswordEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config RedisPasswordEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Length.IsNull() && !config.Length.IsUnknown() {
+		if length := config.Length.ValueInt64(); length < 1 || length > maxPasswordLength {
+			resp.Diagnostics.AddAttributeError(path.Root("length"), "Invalid length", fmt.Sprintf("length must be between 1 and %d", maxPasswordLength))
+		}
+	}
+	if !config.MinEntropyBits.IsNull() && !config.MinEntropyBits.IsUnknown() {
+		if bits := config.MinEntropyBits.ValueInt64(); bits < 0 || bits > maxPasswordEntropyBits {
+			resp.Diagnostics.AddAttributeError(path.Root("min_entropy_bits"), "Invalid entropy", fmt.Sprintf("min_entropy_bits must be between 0 and %d", maxPasswordEntropyBits))
+		}
+	}
+	if !config.Charset.IsNull() && !config.Characters.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("characters"), "Conflicting attributes", "characters cannot be set together with charset")
+	}
+	alphabet, err := passwordAlphabet(&config)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("charset"), "Invalid character set", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if length := passwordLength(&config, len(alphabet)); length > maxPasswordLength {
+		resp.Diagnostics.AddAttributeError(path.Root("min_entropy_bits"), "Invalid entropy", fmt.Sprintf("min_entropy_bits needs %d characters with this character set, more than the maximum length of %d", length, maxPasswordLength))
+	}
+}
+
+func (r *RedisPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data RedisPasswordEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alphabet, err := passwordAlphabet(&data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("charset"), "Invalid character set", err.Error())
+		return
+	}
+
+	length := passwordLength(&data, len(alphabet))
+	if length < 1 || length > maxPasswordLength {
+		resp.Diagnostics.AddAttributeError(path.Root("length"), "Invalid length", fmt.Sprintf("password length %d is not between 1 and %d", length, maxPasswordLength))
+		return
+	}
+	password, err := generatePassword(alphabet, length)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to generate password", err.Error())
+		return
+	}
+
+	data.Result = types.StringValue(password)
+	data.Hash = types.StringValue(hashPassword(password))
+	data.EntropyBits = types.Float64Value(passwordEntropyBits(len(alphabet), length))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// passwordAlphabet returns the distinct characters to draw passwords from.
+func passwordAlphabet(m *RedisPasswordEphemeralResourceModel) ([]rune, error) {
+	characters := passwordCharsets["alphanumeric"]
+	if !m.Characters.IsNull() && !m.Characters.IsUnknown() {
+		characters = m.Characters.ValueString()
+	} else if !m.Charset.IsNull() && !m.Charset.IsUnknown() {
+		named, ok := passwordCharsets[m.Charset.ValueString()]
+		if !ok {
+			names := make([]string, 0, len(passwordCharsets))
+			for name := range passwordCharsets {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("unsupported charset %q, expected one of %s", m.Charset.ValueString(), strings.Join(names, ", "))
+		}
+		characters = named
+	}
+
+	seen := map[rune]bool{}
+	alphabet := []rune{}
+	for _, c := range characters {
+		if !seen[c] {
+			seen[c] = true
+			alphabet = append(alphabet, c)
+		}
+	}
+	if len(alphabet) < 2 {
+		return nil, fmt.Errorf("at least two distinct characters are required")
+	}
+	return alphabet, nil
+}
+
+// passwordLength returns the configured length, raised to the smallest length
+// reaching min_entropy_bits with an alphabet of the given size.
+func passwordLength(m *RedisPasswordEphemeralResourceModel, alphabetSize int) int {
+	length := defaultPasswordLength
+	if !m.Length.IsNull() && !m.Length.IsUnknown() {
+		length = int(m.Length.ValueInt64())
+	}
+	if !m.MinEntropyBits.IsNull() && !m.MinEntropyBits.IsUnknown() {
+		needed := int(math.Ceil(float64(m.MinEntropyBits.ValueInt64()) / math.Log2(float64(alphabetSize))))
+		length = max(length, needed)
+	}
+	return length
+}
+
+func passwordEntropyBits(alphabetSize int, length int) float64 {
+	return float64(length) * math.Log2(float64(alphabetSize))
+}
+
+// generatePassword draws length characters uniformly from alphabet using
+// crypto/rand.
+func generatePassword(alphabet []rune, length int) (string, error) {
+	var b strings.Builder
+	limit := big.NewInt(int64(len(alphabet)))
+	for range length {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", err
+		}
+		b.WriteRune(alphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEphemeralConfig renders model as the config of an ephemeral resource.
+func newEphemeralConfig(t *testing.T, r ephemeral.EphemeralResource, model any) (tfsdk.Config, tfsdk.EphemeralResultData) {
+	t.Helper()
+	ctx := context.Background()
+	resp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, model)
+	require.False(t, diags.HasError(), diags)
+
+	return tfsdk.Config{Schema: resp.Schema, Raw: state.Raw},
+		tfsdk.EphemeralResultData{Schema: resp.Schema, Raw: state.Raw}
+}
+
+func TestRedisPasswordEphemeralResource_Metadata(t *testing.T) {
+	t.Run("sets correct type name", func(t *testing.T) {
+		r := NewRedisPasswordEphemeralResource()
+		resp := &ephemeral.MetadataResponse{}
+
+		r.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "redis"}, resp)
+
+		assert.Equal(t, "redis_password", resp.TypeName)
+	})
+}
+
+func TestRedisPasswordEphemeralResource_Open(t *testing.T) {
+	t.Run("generates a password with its hash", func(t *testing.T) {
+		ctx := context.Background()
+		r := NewRedisPasswordEphemeralResource()
+		config, result := newEphemeralConfig(t, r, &RedisPasswordEphemeralResourceModel{
+			Length:  types.Int64Value(20),
+			Charset: types.StringValue("hex"),
+		})
+		resp := &ephemeral.OpenResponse{Result: result}
+
+		r.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		var data RedisPasswordEphemeralResourceModel
+		require.False(t, resp.Result.Get(ctx, &data).HasError())
+		assert.Len(t, data.Result.ValueString(), 20)
+		assert.Empty(t, strings.Trim(data.Result.ValueString(), passwordCharsets["hex"]))
+		assert.Equal(t, hashPassword(data.Result.ValueString()), data.Hash.ValueString())
+		assert.InDelta(t, 80, data.EntropyBits.ValueFloat64(), 0.001)
+	})
+
+	t.Run("rejects a length above the maximum", func(t *testing.T) {
+		r := NewRedisPasswordEphemeralResource()
+		config, result := newEphemeralConfig(t, r, &RedisPasswordEphemeralResourceModel{
+			Length: types.Int64Value(maxPasswordLength + 1),
+		})
+		resp := &ephemeral.OpenResponse{Result: result}
+
+		r.Open(context.Background(), ephemeral.OpenRequest{Config: config}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestRedisPasswordEphemeralResource_ValidateConfig(t *testing.T) {
+	validate := func(t *testing.T, model *RedisPasswordEphemeralResourceModel) *ephemeral.ValidateConfigResponse {
+		t.Helper()
+		r := &RedisPasswordEphemeralResource{}
+		config, _ := newEphemeralConfig(t, r, model)
+		resp := &ephemeral.ValidateConfigResponse{}
+		r.ValidateConfig(context.Background(), ephemeral.ValidateConfigRequest{Config: config}, resp)
+		return resp
+	}
+
+	t.Run("accepts the maximum length and entropy", func(t *testing.T) {
+		resp := validate(t, &RedisPasswordEphemeralResourceModel{
+			Length:         types.Int64Value(maxPasswordLength),
+			MinEntropyBits: types.Int64Value(maxPasswordEntropyBits),
+			Charset:        types.StringValue("hex"),
+		})
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	})
+
+	t.Run("rejects a length above the maximum", func(t *testing.T) {
+		resp := validate(t, &RedisPasswordEphemeralResourceModel{Length: types.Int64Value(2000000000)})
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("rejects entropy above the maximum", func(t *testing.T) {
+		resp := validate(t, &RedisPasswordEphemeralResourceModel{MinEntropyBits: types.Int64Value(maxPasswordEntropyBits + 1)})
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("rejects entropy needing more than the maximum length", func(t *testing.T) {
+		resp := validate(t, &RedisPasswordEphemeralResourceModel{
+			MinEntropyBits: types.Int64Value(2048),
+			Characters:     types.StringValue("01"),
+		})
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestPasswordAlphabet(t *testing.T) {
+	t.Run("defaults to alphanumeric", func(t *testing.T) {
+		alphabet, err := passwordAlphabet(&RedisPasswordEphemeralResourceModel{})
+
+		require.NoError(t, err)
+		assert.Len(t, alphabet, 62)
+	})
+
+	t.Run("deduplicates custom characters", func(t *testing.T) {
+		alphabet, err := passwordAlphabet(&RedisPasswordEphemeralResourceModel{Characters: types.StringValue("abcabc")})
+
+		require.NoError(t, err)
+		assert.Equal(t, []rune("abc"), alphabet)
+	})
+
+	t.Run("rejects unknown charset", func(t *testing.T) {
+		_, err := passwordAlphabet(&RedisPasswordEphemeralResourceModel{Charset: types.StringValue("emoji")})
+
+		assert.ErrorContains(t, err, "alphanumeric, base64url, hex, printable")
+	})
+
+	t.Run("rejects a single character", func(t *testing.T) {
+		_, err := passwordAlphabet(&RedisPasswordEphemeralResourceModel{Characters: types.StringValue("aaaa")})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestPasswordLength(t *testing.T) {
+	t.Run("defaults length", func(t *testing.T) {
+		assert.Equal(t, defaultPasswordLength, passwordLength(&RedisPasswordEphemeralResourceModel{}, 62))
+	})
+
+	t.Run("raises length to reach entropy", func(t *testing.T) {
+		length := passwordLength(&RedisPasswordEphemeralResourceModel{
+			Length:         types.Int64Value(8),
+			MinEntropyBits: types.Int64Value(128),
+		}, 16)
+
+		assert.Equal(t, 32, length)
+	})
+
+	t.Run("keeps longer configured length", func(t *testing.T) {
+		length := passwordLength(&RedisPasswordEphemeralResourceModel{
+			Length:         types.Int64Value(64),
+			MinEntropyBits: types.Int64Value(128),
+		}, 16)
+
+		assert.Equal(t, 64, length)
+	})
+}
+
+func TestGeneratePassword(t *testing.T) {
+	t.Run("draws only from the alphabet", func(t *testing.T) {
+		password, err := generatePassword([]rune("ab"), 64)
+
+		require.NoError(t, err)
+		assert.Len(t, password, 64)
+		assert.Empty(t, strings.Trim(password, "ab"))
+	})
+
+	t.Run("generates distinct passwords", func(t *testing.T) {
+		first, err := generatePassword([]rune(passwordCharsets["alphanumeric"]), 32)
+		require.NoError(t, err)
+		second, err := generatePassword([]rune(passwordCharsets["alphanumeric"]), 32)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+}
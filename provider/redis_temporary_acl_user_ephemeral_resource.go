@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &RedisTemporaryAclUserEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &RedisTemporaryAclUserEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &RedisTemporaryAclUserEphemeralResource{}
+
+func NewRedisTemporaryAclUserEphemeralResource() ephemeral.EphemeralResource {
+	return &RedisTemporaryAclUserEphemeralResource{}
+}
+
+type RedisTemporaryAclUserEphemeralResource struct {
+	providerData *RedisProviderData
+}
+
+type RedisTemporaryAclUserEphemeralResourceModel struct {
+	NamePrefix         types.String `tfsdk:"name_prefix"`
+	Commands           types.List   `tfsdk:"commands"`
+	ExcludedCommands   types.List   `tfsdk:"excluded_commands"`
+	Categories         types.List   `tfsdk:"categories"`
+	ExcludedCategories types.List   `tfsdk:"excluded_categories"`
+	Keys               types.List   `tfsdk:"keys"`
+	ReadonlyKeys       types.List   `tfsdk:"readonly_keys"`
+	WriteonlyKeys      types.List   `tfsdk:"writeonly_keys"`
+	Channels           types.List   `tfsdk:"channels"`
+	Name               types.String `tfsdk:"name"`
+	Password           types.String `tfsdk:"password"`
+}
+
+const (
+	defaultTemporaryUserPrefix = "tmp-"
+	privateTemporaryUserKey    = "name"
+)
+
+func (r *RedisTemporaryAclUserEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*RedisProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *RedisProviderData, got %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+func (r *RedisTemporaryAclUserEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_temporary_acl_user"
+}
+
+func (r *RedisTemporaryAclUserEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	permission := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: description,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Creates a uniquely named ACL user with a random password for the duration of a Terraform run and deletes it afterwards.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Prefix of the generated user name. Defaults to '%s'.", defaultTemporaryUserPrefix),
+			},
+			"commands":            permission("ACL commands for the user (e.g., 'config|get', 'keys', 'all')."),
+			"excluded_commands":   permission("ACL commands to exclude for the user."),
+			"categories":          permission("ACL categories for the user (e.g., 'read', 'write', 'admin')."),
+			"excluded_categories": permission("ACL categories to exclude for the user (e.g., 'dangerous')."),
+			"keys":                permission("Key patterns the user can access (without ~ prefix)."),
+			"readonly_keys":       permission("Key patterns the user can only read (without %R~ prefix)."),
+			"writeonly_keys":      permission("Key patterns the user can only write (without %W~ prefix)."),
+			"channels":            permission("Pub/Sub channel patterns the user can access (without & prefix)."),
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Generated name of the ACL user.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Random password of the ACL user.",
+			},
+		},
+	}
+}
+
+func (r *RedisTemporaryAclUserEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data RedisTemporaryAclUserEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.createUser(ctx, &data, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		// Terraform does not call Close after a failed Open.
+		resp.Diagnostics.Append(r.deleteUser(ctx, resp.Private)...)
+	}
+}
+
+func (r *RedisTemporaryAclUserEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	resp.Diagnostics.Append(r.deleteUser(ctx, req.Private)...)
+}
+
+// createUser creates the user described by data under a generated name and
+// password, fills both into data and records the name for deleteUser.
+func (r *RedisTemporaryAclUserEphemeralResource) createUser(ctx context.Context, data *RedisTemporaryAclUserEphemeralResourceModel, private privateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name, err := temporaryUserName(data.NamePrefix)
+	if err != nil {
+		diags.AddError("Failed to generate user name", err.Error())
+		return diags
+	}
+	password, err := generatePassword([]rune(passwordCharsets["alphanumeric"]), defaultPasswordLength)
+	if err != nil {
+		diags.AddError("Failed to generate password", err.Error())
+		return diags
+	}
+
+	users := &RedisAclUserResource{providerData: r.providerData}
+	if _, err := users.AclSetUser(data.aclUserModel(name), ctx, []string{hashPassword(password)}); err != nil {
+		diags.AddError("Failed to create temporary ACL user", err.Error())
+		return diags
+	}
+
+	// Close is only called for a recorded user, so an unrecorded one is
+	// deleted right away.
+	if d := setPrivateTemporaryUser(ctx, private, name); d.HasError() {
+		diags.Append(d...)
+		if _, err := users.AclDelUser(name, ctx, false); err != nil {
+			diags.AddError("Failed to roll back temporary ACL user", err.Error())
+		}
+		return diags
+	}
+	data.Name = types.StringValue(name)
+	data.Password = types.StringValue(password)
+	return diags
+}
+
+// deleteUser deletes the user recorded by createUser, if any.
+func (r *RedisTemporaryAclUserEphemeralResource) deleteUser(ctx context.Context, private privateState) diag.Diagnostics {
+	name, diags := getPrivateTemporaryUser(ctx, private)
+	if diags.HasError() || name == "" {
+		return diags
+	}
+
+	users := &RedisAclUserResource{providerData: r.providerData}
+	if _, err := users.AclDelUser(name, ctx, false); err != nil {
+		diags.AddError("Failed to delete temporary ACL user", err.Error())
+	}
+	return diags
+}
+
+// aclUserModel returns the redis_acl_user model granting the permissions of
+// the temporary user. The user is never written to the ACL file.
+func (m *RedisTemporaryAclUserEphemeralResourceModel) aclUserModel(name string) *RedisAclUserResourceModel {
+	return &RedisAclUserResourceModel{
+		Name:               types.StringValue(name),
+		Enabled:            types.BoolValue(true),
+		Commands:           m.Commands,
+		ExcludedCommands:   m.ExcludedCommands,
+		Categories:         m.Categories,
+		ExcludedCategories: m.ExcludedCategories,
+		Keys:               m.Keys,
+		ReadonlyKeys:       m.ReadonlyKeys,
+		WriteonlyKeys:      m.WriteonlyKeys,
+		Channels:           m.Channels,
+		AclSave:            types.BoolValue(false),
+	}
+}
+
+// temporaryUserName appends a random suffix to prefix so concurrent runs get
+// distinct users.
+func temporaryUserName(prefix types.String) (string, error) {
+	name := defaultTemporaryUserPrefix
+	if !prefix.IsNull() && !prefix.IsUnknown() {
+		name = prefix.ValueString()
+	}
+	suffix, err := generatePassword([]rune(passwordCharsets["hex"]), 16)
+	if err != nil {
+		return "", err
+	}
+	return name + suffix, nil
+}
+
+func getPrivateTemporaryUser(ctx context.Context, private privateState) (string, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, privateTemporaryUserKey)
+	if diags.HasError() || len(data) == 0 {
+		return "", diags
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		diags.AddError("Failed to decode private state", err.Error())
+		return "", diags
+	}
+	return name, diags
+}
+
+func setPrivateTemporaryUser(ctx context.Context, private privateState, name string) diag.Diagnostics {
+	data, err := json.Marshal(name)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to encode private state", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, privateTemporaryUserKey, data)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisTemporaryAclUserEphemeralResource_Metadata(t *testing.T) {
+	t.Run("sets correct type name", func(t *testing.T) {
+		r := NewRedisTemporaryAclUserEphemeralResource()
+		resp := &ephemeral.MetadataResponse{}
+
+		r.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "redis"}, resp)
+
+		assert.Equal(t, "redis_temporary_acl_user", resp.TypeName)
+	})
+}
+
+func TestRedisTemporaryAclUserEphemeralResource_Configure(t *testing.T) {
+	t.Run("sets provider data", func(t *testing.T) {
+		r := &RedisTemporaryAclUserEphemeralResource{}
+		providerData := &RedisProviderData{}
+		resp := &ephemeral.ConfigureResponse{}
+
+		r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: providerData}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Same(t, providerData, r.providerData)
+	})
+
+	t.Run("rejects unexpected provider data", func(t *testing.T) {
+		r := &RedisTemporaryAclUserEphemeralResource{}
+		resp := &ephemeral.ConfigureResponse{}
+
+		r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestTemporaryUserName(t *testing.T) {
+	t.Run("uses default prefix", func(t *testing.T) {
+		name, err := temporaryUserName(types.StringNull())
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(name, defaultTemporaryUserPrefix))
+		assert.Len(t, name, len(defaultTemporaryUserPrefix)+16)
+	})
+
+	t.Run("generates distinct names with custom prefix", func(t *testing.T) {
+		first, err := temporaryUserName(types.StringValue("ci-"))
+		require.NoError(t, err)
+		second, err := temporaryUserName(types.StringValue("ci-"))
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(first, "ci-"))
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func TestTemporaryAclUserModel(t *testing.T) {
+	t.Run("grants permissions without saving", func(t *testing.T) {
+		data := &RedisTemporaryAclUserEphemeralResourceModel{
+			Categories: stringListValue("read"),
+			Keys:       stringListValue("ci:*"),
+		}
+
+		rules := buildACLRules(data.aclUserModel("tmp-1"), []string{"abc"})
+
+		assert.Equal(t, []string{"reset", "on", "#abc", "~ci:*", "+@read"}, rules)
+		assert.False(t, data.aclUserModel("tmp-1").AclSave.ValueBool())
+	})
+}
+
+// failingPrivateState rejects every write.
+type failingPrivateState struct{ fakePrivateState }
+
+func (f failingPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError("Failed to set private state", "private state is read-only")
+	return diags
+}
+
+func TestRedisTemporaryAclUserEphemeralResource_Integration(t *testing.T) {
+	if os.Getenv("INTEGRATION") == "" {
+		t.Skip("set INTEGRATION=1 to run integration tests")
+	}
+	t.Run("creates and deletes a temporary user", func(t *testing.T) {
+		ctx := context.Background()
+		users := newIntegrationAclUserResource(t)
+		r := &RedisTemporaryAclUserEphemeralResource{providerData: users.providerData}
+		private := fakePrivateState{}
+		data := &RedisTemporaryAclUserEphemeralResourceModel{
+			NamePrefix: types.StringValue("it-"),
+			Categories: stringListValue("read"),
+		}
+
+		diags := r.createUser(ctx, data, private)
+		require.False(t, diags.HasError(), diags)
+		_, err := users.AclGetUser(data.Name.ValueString(), ctx)
+		require.NoError(t, err)
+
+		diags = r.deleteUser(ctx, private)
+		require.False(t, diags.HasError(), diags)
+		_, err = users.AclGetUser(data.Name.ValueString(), ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("deletes the user when it cannot be recorded", func(t *testing.T) {
+		ctx := context.Background()
+		users := newIntegrationAclUserResource(t)
+		r := &RedisTemporaryAclUserEphemeralResource{providerData: users.providerData}
+		data := &RedisTemporaryAclUserEphemeralResourceModel{
+			NamePrefix: types.StringValue("it-rollback-"),
+			Categories: stringListValue("read"),
+		}
+
+		diags := r.createUser(ctx, data, failingPrivateState{fakePrivateState{}})
+		require.True(t, diags.HasError())
+
+		names, err := users.providerData.Client.Do(ctx, "ACL", "USERS").StringSlice()
+		require.NoError(t, err)
+		for _, name := range names {
+			assert.False(t, strings.HasPrefix(name, "it-rollback-"), name)
+		}
+	})
+}
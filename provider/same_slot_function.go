@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &SameSlotFunction{}
+
+func NewSameSlotFunction() function.Function {
+	return &SameSlotFunction{}
+}
+
+// SameSlotFunction reports whether keys hash to the same Redis Cluster slot,
+// so multi-key commands on them are allowed in cluster mode.
+type SameSlotFunction struct{}
+
+func (f *SameSlotFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "same_slot"
+}
+
+func (f *SameSlotFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check that keys share a cluster hash slot",
+		Description: "Returns true when every key hashes to the same Redis Cluster slot, as computed by key_slot. An empty list or a single key returns true.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "keys",
+				ElementType: types.StringType,
+				Description: "Keys to compare.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *SameSlotFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var keys []types.String
+	resp.Error = req.Arguments.Get(ctx, &keys)
+	if resp.Error != nil {
+		return
+	}
+
+	same, err := sameSlot(keys)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, same)
+}
+
+func sameSlot(keys []types.String) (bool, error) {
+	slot := -1
+	for i, key := range keys {
+		if key.IsNull() {
+			return false, fmt.Errorf("keys[%d] must not be null", i)
+		}
+		s := keySlot(key.ValueString())
+		if slot >= 0 && s != slot {
+			return false, nil
+		}
+		slot = s
+	}
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runSameSlotFunction(t *testing.T, keys ...string) types.Bool {
+	t.Helper()
+	resp := &function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+
+	NewSameSlotFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{stringListValue(keys...)}),
+	}, resp)
+
+	require.Nil(t, resp.Error)
+	result, ok := resp.Result.Value().(types.Bool)
+	require.True(t, ok)
+	return result
+}
+
+func TestSameSlotFunction_Metadata(t *testing.T) {
+	t.Run("sets function name", func(t *testing.T) {
+		resp := &function.MetadataResponse{}
+
+		NewSameSlotFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+		assert.Equal(t, "same_slot", resp.Name)
+	})
+}
+
+func TestSameSlotFunction_Run(t *testing.T) {
+	t.Run("keys sharing a hash tag", func(t *testing.T) {
+		assert.True(t, runSameSlotFunction(t, "{user1000}.following", "{user1000}.followers").ValueBool())
+	})
+
+	t.Run("keys in different slots", func(t *testing.T) {
+		assert.False(t, runSameSlotFunction(t, "foo", "bar").ValueBool())
+	})
+
+	t.Run("empty list and single key", func(t *testing.T) {
+		assert.True(t, runSameSlotFunction(t).ValueBool())
+		assert.True(t, runSameSlotFunction(t, "foo").ValueBool())
+	})
+}
+
+func TestSameSlot(t *testing.T) {
+	t.Run("rejects null keys", func(t *testing.T) {
+		_, err := sameSlot([]types.String{types.StringValue("foo"), types.StringNull()})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultOperationTimeout = 5 * time.Minute
+
+type TimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+func timeoutsBlock() schema.SingleNestedBlock {
+	attribute := func(operation string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Time limit for %s operations, as a duration string (e.g. 30s, 2m). Defaults to 5m.", operation),
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: "Time limits for Redis calls made by each operation.",
+		Attributes: map[string]schema.Attribute{
+			"create": attribute("create"),
+			"read":   attribute("read"),
+			"update": attribute("update"),
+			"delete": attribute("delete"),
+		},
+	}
+}
+
+func (t *TimeoutsModel) duration(operation string) (time.Duration, error) {
+	if t == nil {
+		return defaultOperationTimeout, nil
+	}
+
+	var value types.String
+	switch operation {
+	case "create":
+		value = t.Create
+	case "read":
+		value = t.Read
+	case "update":
+		value = t.Update
+	case "delete":
+		value = t.Delete
+	default:
+		return 0, fmt.Errorf("unknown operation %q", operation)
+	}
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return defaultOperationTimeout, nil
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s timeout: %w", operation, err)
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("invalid %s timeout: must be positive", operation)
+	}
+	return duration, nil
+}
+
+// validate reports every configured timeout that is not a positive duration,
+// so a typo fails at plan time instead of in the first operation using it.
+func (t *TimeoutsModel) validate(diags *diag.Diagnostics) {
+	for _, operation := range []string{"create", "read", "update", "delete"} {
+		if _, err := t.duration(operation); err != nil {
+			diags.AddAttributeError(path.Root("timeouts").AtName(operation), "Invalid timeout", err.Error())
+		}
+	}
+}
+
+// withTimeout bounds ctx by the timeout configured for operation.
+func (t *TimeoutsModel) withTimeout(ctx context.Context, operation string) (context.Context, context.CancelFunc, error) {
+	duration, err := t.duration(operation)
+	if err != nil {
+		return ctx, func() {}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	return ctx, cancel, nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutsModel_duration(t *testing.T) {
+	t.Run("uses default without timeouts block", func(t *testing.T) {
+		var timeouts *TimeoutsModel
+
+		duration, err := timeouts.duration("create")
+
+		require.NoError(t, err)
+		assert.Equal(t, defaultOperationTimeout, duration)
+	})
+
+	t.Run("uses default for unset operation", func(t *testing.T) {
+		timeouts := &TimeoutsModel{
+			Create: types.StringValue("30s"),
+			Read:   types.StringNull(),
+		}
+
+		duration, err := timeouts.duration("read")
+
+		require.NoError(t, err)
+		assert.Equal(t, defaultOperationTimeout, duration)
+	})
+
+	t.Run("parses configured durations", func(t *testing.T) {
+		timeouts := &TimeoutsModel{
+			Create: types.StringValue("30s"),
+			Read:   types.StringValue("10s"),
+			Update: types.StringValue("1m"),
+			Delete: types.StringValue("2m30s"),
+		}
+
+		for operation, expected := range map[string]time.Duration{
+			"create": 30 * time.Second,
+			"read":   10 * time.Second,
+			"update": time.Minute,
+			"delete": 150 * time.Second,
+		} {
+			duration, err := timeouts.duration(operation)
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, duration, operation)
+		}
+	})
+
+	t.Run("rejects invalid durations", func(t *testing.T) {
+		timeouts := &TimeoutsModel{
+			Create: types.StringValue("soon"),
+			Delete: types.StringValue("-1s"),
+		}
+
+		_, err := timeouts.duration("create")
+		assert.Error(t, err)
+
+		_, err = timeouts.duration("delete")
+		assert.Error(t, err)
+	})
+}
+
+func TestTimeoutsModel_withTimeout(t *testing.T) {
+	t.Run("sets a deadline on the context", func(t *testing.T) {
+		timeouts := &TimeoutsModel{
+			Update: types.StringValue("1m"),
+		}
+
+		ctx, cancel, err := timeouts.withTimeout(context.Background(), "update")
+		defer cancel()
+
+		require.NoError(t, err)
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+	})
+
+	t.Run("returns parse errors", func(t *testing.T) {
+		timeouts := &TimeoutsModel{
+			Read: types.StringValue("later"),
+		}
+
+		ctx, cancel, err := timeouts.withTimeout(context.Background(), "read")
+		defer cancel()
+
+		assert.Error(t, err)
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+}
+
+func TestTimeoutsModel_validate(t *testing.T) {
+	t.Run("accepts a missing block and valid durations", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		(*TimeoutsModel)(nil).validate(&diags)
+		(&TimeoutsModel{Read: types.StringValue("30s"), Update: types.StringUnknown()}).validate(&diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("reports invalid durations at their path", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		(&TimeoutsModel{Read: types.StringValue("5 min"), Delete: types.StringValue("0s")}).validate(&diags)
+
+		require.Len(t, diags, 2)
+		assert.Equal(t, path.Root("timeouts").AtName("read"), diags[0].(diag.DiagnosticWithPath).Path())
+		assert.Equal(t, path.Root("timeouts").AtName("delete"), diags[1].(diag.DiagnosticWithPath).Path())
+	})
+}
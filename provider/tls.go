@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RedisProviderTLSModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	CACert             types.String `tfsdk:"ca_cert"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	ServerName         types.String `tfsdk:"server_name"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	MinVersion         types.String `tfsdk:"min_version"`
+}
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// buildTLSConfig returns nil when the tls block is absent or disabled.
+func buildTLSConfig(m *RedisProviderTLSModel) (*tls.Config, error) {
+	if m == nil {
+		return nil, nil
+	}
+	if !m.Enabled.IsNull() && !m.Enabled.IsUnknown() && !m.Enabled.ValueBool() {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		ServerName:         m.ServerName.ValueString(),
+		InsecureSkipVerify: m.InsecureSkipVerify.ValueBool(),
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if v := m.MinVersion.ValueString(); v != "" {
+		version, ok := tlsVersions[v]
+		if !ok {
+			return nil, fmt.Errorf("unsupported min_version %q, expected one of 1.0, 1.1, 1.2, 1.3", v)
+		}
+		config.MinVersion = version
+	}
+
+	if v := m.CACert.ValueString(); v != "" {
+		caPEM, err := readPEM(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("ca_cert does not contain any valid PEM certificates")
+		}
+		config.RootCAs = pool
+	}
+
+	clientCert, clientKey := m.ClientCert.ValueString(), m.ClientKey.ValueString()
+	if (clientCert == "") != (clientKey == "") {
+		return nil, fmt.Errorf("client_cert and client_key must be set together")
+	}
+	if clientCert != "" {
+		certPEM, err := readPEM(clientCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client_cert: %w", err)
+		}
+		keyPEM, err := readPEM(clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client_key: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// readPEM accepts either inline PEM content or a path to a PEM file.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateTestCertificate(t *testing.T) (certPEM, keyPEM string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "redis-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certPEM, keyPEM
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	t.Run("returns nil without tls block", func(t *testing.T) {
+		config, err := buildTLSConfig(nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("returns nil when disabled", func(t *testing.T) {
+		config, err := buildTLSConfig(&RedisProviderTLSModel{
+			Enabled: types.BoolValue(false),
+		})
+
+		assert.NoError(t, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("defaults to TLS 1.2", func(t *testing.T) {
+		config, err := buildTLSConfig(&RedisProviderTLSModel{})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+		assert.False(t, config.InsecureSkipVerify)
+	})
+
+	t.Run("sets server name, min version and insecure flag", func(t *testing.T) {
+		config, err := buildTLSConfig(&RedisProviderTLSModel{
+			Enabled:            types.BoolValue(true),
+			ServerName:         types.StringValue("redis.internal"),
+			InsecureSkipVerify: types.BoolValue(true),
+			MinVersion:         types.StringValue("1.3"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "redis.internal", config.ServerName)
+		assert.True(t, config.InsecureSkipVerify)
+		assert.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+	})
+
+	t.Run("rejects unknown min version", func(t *testing.T) {
+		_, err := buildTLSConfig(&RedisProviderTLSModel{
+			MinVersion: types.StringValue("2.0"),
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("loads inline CA certificate", func(t *testing.T) {
+		certPEM, _ := generateTestCertificate(t)
+
+		config, err := buildTLSConfig(&RedisProviderTLSModel{
+			CACert: types.StringValue(certPEM),
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, config.RootCAs)
+	})
+
+	t.Run("loads client certificate from files", func(t *testing.T) {
+		certPEM, keyPEM := generateTestCertificate(t)
+		dir := t.TempDir()
+		certPath := filepath.Join(dir, "client.crt")
+		keyPath := filepath.Join(dir, "client.key")
+		require.NoError(t, os.WriteFile(certPath, []byte(certPEM), 0o600))
+		require.NoError(t, os.WriteFile(keyPath, []byte(keyPEM), 0o600))
+
+		config, err := buildTLSConfig(&RedisProviderTLSModel{
+			ClientCert: types.StringValue(certPath),
+			ClientKey:  types.StringValue(keyPath),
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, config.Certificates, 1)
+	})
+
+	t.Run("requires client cert and key together", func(t *testing.T) {
+		certPEM, _ := generateTestCertificate(t)
+
+		_, err := buildTLSConfig(&RedisProviderTLSModel{
+			ClientCert: types.StringValue(certPEM),
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects invalid CA certificate", func(t *testing.T) {
+		_, err := buildTLSConfig(&RedisProviderTLSModel{
+			CACert: types.StringValue("-----BEGIN CERTIFICATE-----\nnope\n-----END CERTIFICATE-----"),
+		})
+
+		assert.Error(t, err)
+	})
+}